@@ -0,0 +1,225 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	adminpb "google.golang.org/genproto/googleapis/iam/admin/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// FileRoleSource lists roles from the output of
+// `gcloud iam roles list` or `gcloud iam roles describe`
+// exported in json or yaml format.
+//
+// The files are read each time roles are listed. Roles exported by
+// `gcloud iam roles list` do not include their permissions and are listed
+// with nil included permissions, if the same role is also exported by
+// `gcloud iam roles describe` the described role takes precedence.
+type FileRoleSource struct {
+	paths []string
+}
+
+func NewFileRoleSource(paths ...string) *FileRoleSource {
+	if len(paths) == 0 {
+		panic("no paths")
+	}
+
+	return &FileRoleSource{paths: paths}
+}
+
+type gcloudRole struct {
+	Name                string   `json:"name" yaml:"name"`
+	Title               string   `json:"title" yaml:"title"`
+	Description         string   `json:"description" yaml:"description"`
+	Stage               string   `json:"stage" yaml:"stage"`
+	Etag                string   `json:"etag" yaml:"etag"`
+	IncludedPermissions []string `json:"includedPermissions" yaml:"includedPermissions"`
+	Deleted             bool     `json:"deleted" yaml:"deleted"`
+}
+
+func (s *FileRoleSource) ListRoles(ctx context.Context, parent string) ([]*adminpb.Role, error) {
+	roles, err := s.readRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []*adminpb.Role
+	for _, r := range roles {
+		if RoleParent(r.Name) == parent {
+			filtered = append(filtered, r)
+		}
+	}
+
+	return filtered, nil
+}
+
+// Parents returns the organizations/<id> and projects/<id> which
+// define the custom roles found in the files.
+func (s *FileRoleSource) Parents(ctx context.Context) ([]string, error) {
+	roles, err := s.readRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var parents []string
+	for _, r := range roles {
+		parent := RoleParent(r.Name)
+		if parent == "" || seen[parent] {
+			continue
+		}
+
+		seen[parent] = true
+		parents = append(parents, parent)
+	}
+
+	sort.Strings(parents)
+
+	return parents, nil
+}
+
+func (s *FileRoleSource) readRoles() ([]*adminpb.Role, error) {
+	var roles []*adminpb.Role
+	index := map[string]int{}
+
+	for _, path := range s.paths {
+		exported, err := readRoleFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading roles from %s: %w", path, err)
+		}
+
+		for _, e := range exported {
+			r, err := e.toRole()
+			if err != nil {
+				return nil, fmt.Errorf("reading roles from %s: %w", path, err)
+			}
+
+			i, ok := index[r.Name]
+			if !ok {
+				index[r.Name] = len(roles)
+				roles = append(roles, r)
+				continue
+			}
+
+			if r.IncludedPermissions != nil || roles[i].IncludedPermissions == nil {
+				roles[i] = r
+			}
+		}
+	}
+
+	return roles, nil
+}
+
+func readRoleFile(path string) ([]gcloudRole, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return decodeJSONRoles(data)
+	case ".yaml", ".yml":
+		return decodeYAMLRoles(data)
+	default:
+		return nil, errors.New("unsupported file format, expected .json, .yaml or .yml")
+	}
+}
+
+func decodeJSONRoles(data []byte) ([]gcloudRole, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	if data[0] == '[' {
+		var roles []gcloudRole
+		if err := json.Unmarshal(data, &roles); err != nil {
+			return nil, err
+		}
+
+		return roles, nil
+	}
+
+	var r gcloudRole
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+
+	return []gcloudRole{r}, nil
+}
+
+func decodeYAMLRoles(data []byte) ([]gcloudRole, error) {
+	var roles []gcloudRole
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return roles, nil
+			}
+
+			return nil, err
+		}
+
+		if len(doc.Content) == 0 {
+			continue
+		}
+
+		switch doc.Content[0].Kind {
+		case yaml.SequenceNode:
+			var r []gcloudRole
+			if err := doc.Decode(&r); err != nil {
+				return nil, err
+			}
+			roles = append(roles, r...)
+		default:
+			var r gcloudRole
+			if err := doc.Decode(&r); err != nil {
+				return nil, err
+			}
+			roles = append(roles, r)
+		}
+	}
+}
+
+func (r gcloudRole) toRole() (*adminpb.Role, error) {
+	if r.Name == "" {
+		return nil, errors.New("role without a name")
+	}
+
+	etag, err := base64.StdEncoding.DecodeString(r.Etag)
+	if err != nil {
+		return nil, fmt.Errorf("invalid etag for role %s: %w", r.Name, err)
+	}
+
+	var stage adminpb.Role_RoleLaunchStage
+	if r.Stage != "" {
+		s, ok := adminpb.Role_RoleLaunchStage_value[r.Stage]
+		if !ok {
+			return nil, fmt.Errorf("invalid stage %q for role %s", r.Stage, r.Name)
+		}
+		stage = adminpb.Role_RoleLaunchStage(s)
+	}
+
+	return &adminpb.Role{
+		Name:                r.Name,
+		Title:               r.Title,
+		Description:         r.Description,
+		IncludedPermissions: r.IncludedPermissions,
+		Stage:               stage,
+		Etag:                etag,
+		Deleted:             r.Deleted,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package adapters
+
+import (
+	"context"
+
+	admin "cloud.google.com/go/iam/admin/apiv1"
+	adminpb "google.golang.org/genproto/googleapis/iam/admin/v1"
+)
+
+// GcpRoleSource lists roles from the GCP IAM admin api.
+type GcpRoleSource struct {
+	client *admin.IamClient
+}
+
+func NewGcpRoleSource(client *admin.IamClient) *GcpRoleSource {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &GcpRoleSource{client: client}
+}
+
+func (s *GcpRoleSource) ListRoles(ctx context.Context, parent string) ([]*adminpb.Role, error) {
+	token := ""
+	var roles []*adminpb.Role
+	for {
+		resp, err := s.client.ListRoles(ctx, &adminpb.ListRolesRequest{
+			Parent:      parent,
+			PageToken:   token,
+			View:        adminpb.RoleView_FULL,
+			ShowDeleted: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// the full view includes the permissions of every role, even
+		// of roles without any
+		for _, r := range resp.Roles {
+			if r.IncludedPermissions == nil {
+				r.IncludedPermissions = []string{}
+			}
+		}
+
+		token = resp.NextPageToken
+		roles = append(roles, resp.Roles...)
+
+		if token == "" {
+			break
+		}
+	}
+
+	return roles, nil
+}
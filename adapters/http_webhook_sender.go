@@ -0,0 +1,40 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+)
+
+// HttpWebhookSender posts webhook deliveries over http.
+type HttpWebhookSender struct {
+	client *http.Client
+}
+
+func NewHttpWebhookSender(client *http.Client) *HttpWebhookSender {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &HttpWebhookSender{client: client}
+}
+
+func (s *HttpWebhookSender) Send(ctx context.Context, url string, header http.Header, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header = header.Clone()
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// drain the body so that the connection can be reused
+	io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,85 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	adminpb "google.golang.org/genproto/googleapis/iam/admin/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// MemoryRoleSource is an in memory role source which
+// allows the role sync to run without access to GCP.
+type MemoryRoleSource struct {
+	mu    sync.Mutex
+	roles []*adminpb.Role
+	err   error
+}
+
+func NewMemoryRoleSource(roles ...*adminpb.Role) *MemoryRoleSource {
+	s := &MemoryRoleSource{}
+	s.SetRoles(roles...)
+
+	return s
+}
+
+// SetRoles replaces the roles returned by ListRoles.
+func (s *MemoryRoleSource) SetRoles(roles ...*adminpb.Role) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.roles = make([]*adminpb.Role, len(roles))
+	for i, r := range roles {
+		s.roles[i] = cloneRole(r)
+	}
+}
+
+// SetError causes ListRoles to fail with err until it is cleared with nil.
+func (s *MemoryRoleSource) SetError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.err = err
+}
+
+func (s *MemoryRoleSource) ListRoles(ctx context.Context, parent string) ([]*adminpb.Role, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	var roles []*adminpb.Role
+	for _, r := range s.roles {
+		if RoleParent(r.Name) == parent {
+			roles = append(roles, cloneRole(r))
+		}
+	}
+
+	return roles, nil
+}
+
+// cloneRole clones r, keeping included permissions which are empty but
+// not nil, which proto.Clone doesn't distinguish.
+func cloneRole(r *adminpb.Role) *adminpb.Role {
+	c := proto.Clone(r).(*adminpb.Role)
+	if r.IncludedPermissions != nil && c.IncludedPermissions == nil {
+		c.IncludedPermissions = []string{}
+	}
+
+	return c
+}
+
+// RoleParent returns the parent which defines the named role,
+// e.g. organizations/<id> for organizations/<id>/roles/<role>, or
+// an empty string for predefined roles.
+func RoleParent(name string) string {
+	i := strings.LastIndex(name, "/roles/")
+	if i < 0 {
+		return ""
+	}
+
+	return name[:i]
+}
@@ -0,0 +1,43 @@
+// Package apperr defines the errors returned by the commands and queries
+// whose messages are safe to report to the caller.
+package apperr
+
+import "fmt"
+
+// Kind classifies an Error so that callers can report it appropriately.
+type Kind string
+
+const (
+	KindInvalidArgument Kind = "invalid_argument"
+	KindNotFound        Kind = "not_found"
+	KindConflict        Kind = "conflict"
+	KindUnavailable     Kind = "unavailable"
+)
+
+// Error is an error returned by the commands and queries whose message
+// is safe to report to the caller.
+type Error struct {
+	Kind    Kind
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func InvalidArgument(format string, args ...interface{}) error {
+	return &Error{Kind: KindInvalidArgument, Message: fmt.Sprintf(format, args...)}
+}
+
+func NotFound(format string, args ...interface{}) error {
+	return &Error{Kind: KindNotFound, Message: fmt.Sprintf(format, args...)}
+}
@@ -11,10 +11,29 @@ type Application struct {
 }
 
 type Commands struct {
-	UpdateRoles *command.UpdateRolesHandler
+	UpdateRoles     *command.UpdateRolesHandler
+	CreateWebhook   *command.CreateWebhookHandler
+	DeleteWebhook   *command.DeleteWebhookHandler
+	DeliverWebhooks *command.DeliverWebhooksHandler
 }
 
 type Queries struct {
-	RolesWithPermissions *query.RolesWithPermissionsHandler
-	RoleByName           *query.RoleByNameHandler
+	RolesWithPermissions   *query.RolesWithPermissionsHandler
+	MinimalRoleCombination *query.MinimalRoleCombinationHandler
+	RoleByName             *query.RoleByNameHandler
+	LeastPrivilegeRoles    *query.LeastPrivilegeRolesHandler
+	RoleDiff               *query.RoleDiffHandler
+	RelatedRoles           *query.RelatedRolesHandler
+	RoleHistory            *query.RoleHistoryHandler
+	PermissionByName       *query.PermissionByNameHandler
+	ListRoles              *query.ListRolesHandler
+	ListPermissions        *query.ListPermissionsHandler
+	ListServices           *query.ListServicesHandler
+	ListResourceTypes      *query.ListResourceTypesHandler
+	ResourceTypeByName     *query.ResourceTypeByNameHandler
+	ListChanges            *query.ListChangesHandler
+	LastChangeID           *query.LastChangeIDHandler
+	ListWebhooks           *query.ListWebhooksHandler
+	WebhookByID            *query.WebhookByIDHandler
+	ListWebhookDeliveries  *query.ListWebhookDeliveriesHandler
 }
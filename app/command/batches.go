@@ -0,0 +1,21 @@
+package command
+
+// batchSize bounds the number of rows written by a single statement.
+const batchSize = 500
+
+// inBatches calls fn with the bounds of consecutive batches of at most
+// batchSize of n items until fn fails.
+func inBatches(n int, fn func(start, end int) error) error {
+	for start := 0; start < n; start += batchSize {
+		end := start + batchSize
+		if end > n {
+			end = n
+		}
+
+		if err := fn(start, end); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
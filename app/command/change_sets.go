@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rosstimothy/iam/ent"
+)
+
+// recordChanges stores the changes of the roles made by a sync as a change
+// set created at now, unless the sync did not change any role, and schedules
+// its delivery to the webhooks subscribed to the changes.
+func recordChanges(ctx context.Context, tx *ent.Tx, changes []*ent.RoleChangeCreate, now time.Time) error {
+	if len(changes) == 0 {
+		fmt.Println("no roles changed")
+		return nil
+	}
+
+	changeSet, err := tx.ChangeSet.Create().SetCreatedAt(now).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		change.SetChangeSet(changeSet)
+	}
+
+	recorded := make([]*ent.RoleChange, 0, len(changes))
+	err = inBatches(len(changes), func(start, end int) error {
+		c, err := tx.RoleChange.CreateBulk(changes[start:end]...).Save(ctx)
+		recorded = append(recorded, c...)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("recorded %d role changes in change set %d\n", len(changes), changeSet.ID)
+
+	return enqueueDeliveries(ctx, tx, changeSet, recorded, now)
+}
+
+// permissionNames returns the sorted names of perms.
+func permissionNames(perms []*ent.Permission) []string {
+	names := make([]string, len(perms))
+	for i, p := range perms {
+		names[i] = p.Name
+	}
+	sort.Strings(names)
+
+	return names
+}
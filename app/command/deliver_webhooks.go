@@ -0,0 +1,337 @@
+package command
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/rolechange"
+	"github.com/rosstimothy/iam/ent/webhook"
+	"github.com/rosstimothy/iam/ent/webhookdelivery"
+)
+
+const (
+	// maxWebhookAttempts is the number of attempts after which
+	// a delivery is given up on.
+	maxWebhookAttempts = 6
+	// webhookRetryDelay is the delay after the first failed attempt of
+	// a delivery, which doubles with each further failed attempt.
+	webhookRetryDelay = time.Minute
+	// maxWebhookDeliveries bounds the number of deliveries attempted at once.
+	maxWebhookDeliveries = 100
+	// maxDeliveryError is the length of the stored error of a failed attempt.
+	maxDeliveryError = 255
+)
+
+// WebhookSender sends the deliveries to the webhooks.
+type WebhookSender interface {
+	// Send posts body with header to url and returns the status
+	// code of the response.
+	Send(ctx context.Context, url string, header http.Header, body []byte) (int, error)
+}
+
+type DeliverWebhooks struct{}
+
+type DeliverWebhooksHandler struct {
+	client *ent.Client
+	sender WebhookSender
+}
+
+func NewDeliverWebhooksHandler(client *ent.Client, sender WebhookSender) *DeliverWebhooksHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	if sender == nil {
+		panic("nil sender")
+	}
+
+	return &DeliverWebhooksHandler{client: client, sender: sender}
+}
+
+// webhookPayload is the body of a delivery.
+type webhookPayload struct {
+	Delivery  int             `json:"delivery"`
+	Webhook   int             `json:"webhook"`
+	ChangeSet int             `json:"change_set"`
+	SyncedAt  time.Time       `json:"synced_at"`
+	Changes   []webhookChange `json:"changes"`
+}
+
+type webhookChange struct {
+	ID                 int      `json:"id"`
+	Role               string   `json:"role"`
+	Kind               string   `json:"kind"`
+	Title              string   `json:"title"`
+	PreviousTitle      string   `json:"previous_title,omitempty"`
+	Stage              string   `json:"stage"`
+	PreviousStage      string   `json:"previous_stage,omitempty"`
+	PermissionsAdded   []string `json:"permissions_added,omitempty"`
+	PermissionsRemoved []string `json:"permissions_removed,omitempty"`
+}
+
+// Handle attempts the pending deliveries which are due. Failed attempts are
+// retried with exponential backoff until maxWebhookAttempts is reached.
+// Each delivery is signed with the secret of its webhook in the
+// X-Iam-Signature header as sha256=<hex encoded HMAC-SHA256 of the body>.
+//
+// The deliveries to different webhooks are sent concurrently, the deliveries
+// to the same webhook in order until an attempt fails, so that an unresponsive
+// webhook neither delays the other webhooks nor its own deliveries for long.
+// The deliveries following a failed attempt wait for its retry.
+func (h *DeliverWebhooksHandler) Handle(ctx context.Context, _ DeliverWebhooks) (err error) {
+	defer func() {
+		if err != nil {
+			fmt.Println("failed to deliver webhooks")
+		}
+	}()
+
+	now := time.Now().UTC()
+	held, err := heldDeliveries(ctx, h.client, now)
+	if err != nil {
+		return err
+	}
+
+	deliveries, err := h.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Where(held...).
+		WithWebhook().
+		WithChangeSet().
+		Order(ent.Asc(webhookdelivery.FieldID)).
+		Limit(maxWebhookDeliveries).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	requests := make([]webhookRequest, len(deliveries))
+	var webhooks []int
+	byWebhook := map[int][]int{}
+	for i, d := range deliveries {
+		requests[i], err = h.request(ctx, d)
+		if err != nil {
+			return err
+		}
+
+		id := d.Edges.Webhook.ID
+		if _, ok := byWebhook[id]; !ok {
+			webhooks = append(webhooks, id)
+		}
+		byWebhook[id] = append(byWebhook[id], i)
+	}
+
+	// the attempts are sent concurrently but recorded one at a time
+	results := make([]*webhookResult, len(deliveries))
+	var wg sync.WaitGroup
+	for _, id := range webhooks {
+		wg.Add(1)
+		go func(pending []int) {
+			defer wg.Done()
+
+			for _, i := range pending {
+				d := deliveries[i]
+				fmt.Printf("delivering change set %d to webhook %d, attempt %d\n", d.Edges.ChangeSet.ID, d.Edges.Webhook.ID, d.Attempts+1)
+				code, err := h.sender.Send(ctx, d.Edges.Webhook.URL, requests[i].header, requests[i].body)
+				results[i] = &webhookResult{code: code, err: err}
+				if !results[i].succeeded() {
+					return
+				}
+			}
+		}(byWebhook[id])
+	}
+	wg.Wait()
+
+	for i, d := range deliveries {
+		if results[i] == nil {
+			continue
+		}
+
+		if err := h.record(ctx, d, *results[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// heldDeliveries returns predicates leaving out the deliveries to each
+// webhook which follow a pending delivery to it which is not due yet, so
+// that the change sets are delivered in order.
+func heldDeliveries(ctx context.Context, client *ent.Client, now time.Time) ([]predicate.WebhookDelivery, error) {
+	waiting, err := client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtGT(now),
+		).
+		WithWebhook().
+		Order(ent.Asc(webhookdelivery.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var preds []predicate.WebhookDelivery
+	seen := map[int]bool{}
+	for _, d := range waiting {
+		id := d.Edges.Webhook.ID
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		preds = append(preds, webhookdelivery.Not(webhookdelivery.And(
+			webhookdelivery.HasWebhookWith(webhook.ID(id)),
+			webhookdelivery.IDGT(d.ID),
+		)))
+	}
+
+	return preds, nil
+}
+
+// webhookRequest is the signed request of a delivery.
+type webhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// webhookResult is the outcome of an attempted delivery.
+type webhookResult struct {
+	code int
+	err  error
+}
+
+func (r webhookResult) succeeded() bool {
+	return r.err == nil && r.code >= 200 && r.code < 300
+}
+
+// request returns the signed request of the delivery d.
+func (h *DeliverWebhooksHandler) request(ctx context.Context, d *ent.WebhookDelivery) (webhookRequest, error) {
+	w, changeSet := d.Edges.Webhook, d.Edges.ChangeSet
+
+	changes, err := h.client.ChangeSet.QueryChanges(changeSet).
+		Order(ent.Asc(rolechange.FieldID)).
+		All(ctx)
+	if err != nil {
+		return webhookRequest{}, err
+	}
+
+	payload := webhookPayload{
+		Delivery:  d.ID,
+		Webhook:   w.ID,
+		ChangeSet: changeSet.ID,
+		SyncedAt:  changeSet.CreatedAt,
+		Changes:   []webhookChange{},
+	}
+
+	for _, c := range changes {
+		if !subscribed(w, c) {
+			continue
+		}
+
+		change := webhookChange{
+			ID:                 c.ID,
+			Role:               c.Role,
+			Kind:               c.Kind.String(),
+			Title:              c.Title,
+			Stage:              c.Stage.String(),
+			PermissionsAdded:   c.PermissionsAdded,
+			PermissionsRemoved: c.PermissionsRemoved,
+		}
+
+		if c.PreviousTitle != nil {
+			change.PreviousTitle = *c.PreviousTitle
+		}
+
+		if c.PreviousStage != nil {
+			change.PreviousStage = c.PreviousStage.String()
+		}
+
+		payload.Changes = append(payload.Changes, change)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return webhookRequest{}, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set("X-Iam-Delivery", strconv.Itoa(d.ID))
+	header.Set("X-Iam-Signature", webhookSignature(w.Secret, body))
+
+	return webhookRequest{header: header, body: body}, nil
+}
+
+// webhookSignature returns the X-Iam-Signature of body signed with secret.
+func webhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryDelay returns the delay before the next attempt of a delivery
+// whose attempts have failed.
+func retryDelay(attempts int) time.Duration {
+	return webhookRetryDelay << (attempts - 1)
+}
+
+// record records the result of an attempt of the delivery d.
+func (h *DeliverWebhooksHandler) record(ctx context.Context, d *ent.WebhookDelivery, result webhookResult) error {
+	w, changeSet := d.Edges.Webhook, d.Edges.ChangeSet
+
+	attempts := d.Attempts + 1
+	now := time.Now().UTC()
+	update := d.Update().SetAttempts(attempts)
+	if result.code != 0 {
+		update.SetResponseCode(result.code)
+	}
+
+	if result.succeeded() {
+		fmt.Printf("delivered change set %d to webhook %d\n", changeSet.ID, w.ID)
+		return update.SetStatus(webhookdelivery.StatusSucceeded).SetDeliveredAt(now).ClearError().Exec(ctx)
+	}
+
+	sendErr := result.err
+	if sendErr == nil {
+		sendErr = fmt.Errorf("unexpected status code %d", result.code)
+	}
+
+	msg := truncate(sendErr.Error(), maxDeliveryError)
+	update.SetError(msg)
+
+	if attempts >= maxWebhookAttempts {
+		fmt.Printf("giving up delivering change set %d to webhook %d: %s\n", changeSet.ID, w.ID, msg)
+		return update.SetStatus(webhookdelivery.StatusFailed).Exec(ctx)
+	}
+
+	fmt.Printf("failed to deliver change set %d to webhook %d: %s\n", changeSet.ID, w.ID, msg)
+	return update.SetNextAttemptAt(now.Add(retryDelay(attempts))).Exec(ctx)
+}
+
+// truncate returns the longest prefix of s of at most n bytes which does
+// not split a rune.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n]
+}
@@ -0,0 +1,326 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rosstimothy/iam/adapters"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/webhook"
+	"github.com/rosstimothy/iam/ent/webhookdelivery"
+)
+
+type sentRequest struct {
+	url    string
+	header http.Header
+	body   []byte
+}
+
+// fakeSender records the requests it is sent and responds with the code or
+// error configured for their url, and 200 otherwise.
+type fakeSender struct {
+	mu       sync.Mutex
+	codes    map[string]int
+	errs     map[string]error
+	requests []sentRequest
+}
+
+func (s *fakeSender) Send(_ context.Context, url string, header http.Header, body []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, sentRequest{url: url, header: header, body: body})
+	if err := s.errs[url]; err != nil {
+		return 0, err
+	}
+
+	if code, ok := s.codes[url]; ok {
+		return code, nil
+	}
+
+	return http.StatusOK, nil
+}
+
+// sentTo returns the requests sent to url.
+func (s *fakeSender) sentTo(url string) []sentRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []sentRequest
+	for _, r := range s.requests {
+		if r.url == url {
+			requests = append(requests, r)
+		}
+	}
+
+	return requests
+}
+
+func createWebhook(t *testing.T, client *ent.Client, cmd CreateWebhook) int {
+	t.Helper()
+
+	id, err := NewCreateWebhookHandler(client).Handle(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("failed to create webhook %s: %v", cmd.URL, err)
+	}
+
+	return id
+}
+
+func deliver(t *testing.T, client *ent.Client, sender WebhookSender) {
+	t.Helper()
+
+	if err := NewDeliverWebhooksHandler(client, sender).Handle(context.Background(), DeliverWebhooks{}); err != nil {
+		t.Fatalf("failed to deliver webhooks: %v", err)
+	}
+}
+
+func deliveries(t *testing.T, client *ent.Client, url string) []*ent.WebhookDelivery {
+	t.Helper()
+
+	ds, err := client.WebhookDelivery.Query().
+		Where(webhookdelivery.HasWebhookWith(webhook.URL(url))).
+		Order(ent.Asc(webhookdelivery.FieldID)).
+		All(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get deliveries to %s: %v", url, err)
+	}
+
+	return ds
+}
+
+// makeDue makes the pending deliveries due immediately.
+func makeDue(t *testing.T, client *ent.Client) {
+	t.Helper()
+
+	err := client.WebhookDelivery.Update().
+		Where(webhookdelivery.StatusEQ(webhookdelivery.StatusPending)).
+		SetNextAttemptAt(time.Now().UTC().Add(-time.Second)).
+		Exec(context.Background())
+	if err != nil {
+		t.Fatalf("failed to update deliveries: %v", err)
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	got := webhookSignature("key", []byte("The quick brown fox jumps over the lazy dog"))
+	want := "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"
+	if got != want {
+		t.Errorf("got signature %s, want %s", got, want)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	want := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 16 * time.Minute}
+	for i, w := range want {
+		if got := retryDelay(i + 1); got != w {
+			t.Errorf("retryDelay(%d) = %s, want %s", i+1, got, w)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s    string
+		n    int
+		want string
+	}{
+		{s: "abc", n: 3, want: "abc"},
+		{s: "abcd", n: 3, want: "abc"},
+		// é is two bytes and is left out rather than split
+		{s: "abé", n: 3, want: "ab"},
+		{s: "abé", n: 4, want: "abé"},
+	}
+
+	for _, tt := range tests {
+		if got := truncate(tt.s, tt.n); got != tt.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.s, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDeliverWebhooks(t *testing.T) {
+	client := newTestClient(t)
+	createWebhook(t, client, CreateWebhook{URL: "http://ok.test", Secret: "s3cret"})
+	createWebhook(t, client, CreateWebhook{URL: "http://storage.test", Secret: "s3cret", RolePatterns: []string{"roles/storage.*"}})
+
+	source := adapters.NewMemoryRoleSource(
+		testRole("roles/viewer", "Viewer", "1", "a.r.get"),
+		testRole("roles/storage.admin", "Storage Admin", "1", "storage.buckets.get"),
+	)
+	runSync(t, client, source)
+
+	sender := &fakeSender{}
+	deliver(t, client, sender)
+
+	requests := sender.sentTo("http://ok.test")
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests to http://ok.test, want 1", len(requests))
+	}
+
+	r := requests[0]
+	if got, want := r.header.Get("X-Iam-Signature"), webhookSignature("s3cret", r.body); got != want {
+		t.Errorf("got signature %s, want %s", got, want)
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(r.body, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+
+	if len(payload.Changes) != 2 {
+		t.Errorf("got %d changes, want 2", len(payload.Changes))
+	}
+
+	// the changes are filtered by the patterns of the webhook
+	requests = sender.sentTo("http://storage.test")
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests to http://storage.test, want 1", len(requests))
+	}
+
+	payload = webhookPayload{}
+	if err := json.Unmarshal(requests[0].body, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+
+	if len(payload.Changes) != 1 || payload.Changes[0].Role != "roles/storage.admin" || payload.Changes[0].Kind != "added" {
+		t.Errorf("got changes %+v, want roles/storage.admin added", payload.Changes)
+	}
+
+	for _, d := range deliveries(t, client, "http://ok.test") {
+		if d.Status != webhookdelivery.StatusSucceeded || d.Attempts != 1 || d.DeliveredAt == nil {
+			t.Errorf("got delivery %v, want it to have succeeded", d)
+		}
+	}
+
+	// webhooks not subscribed to any change get no delivery
+	source.SetRoles(
+		testRole("roles/viewer", "Viewer", "2", "a.r.get", "a.r.list"),
+		testRole("roles/storage.admin", "Storage Admin", "1", "storage.buckets.get"),
+	)
+	runSync(t, client, source)
+
+	if ds := deliveries(t, client, "http://storage.test"); len(ds) != 1 {
+		t.Errorf("got %d deliveries to http://storage.test, want 1", len(ds))
+	}
+
+	if ds := deliveries(t, client, "http://ok.test"); len(ds) != 2 {
+		t.Errorf("got %d deliveries to http://ok.test, want 2", len(ds))
+	}
+}
+
+func TestDeliverWebhooksRetriesFailedDeliveries(t *testing.T) {
+	client := newTestClient(t)
+	createWebhook(t, client, CreateWebhook{URL: "http://fail.test", Secret: "s3cret"})
+	runSync(t, client, adapters.NewMemoryRoleSource(testRole("roles/viewer", "Viewer", "1", "a.r.get")))
+
+	sender := &fakeSender{codes: map[string]int{"http://fail.test": http.StatusInternalServerError}}
+	before := time.Now()
+	deliver(t, client, sender)
+	after := time.Now()
+
+	d := deliveries(t, client, "http://fail.test")[0]
+	if d.Status != webhookdelivery.StatusPending || d.Attempts != 1 || d.ResponseCode != http.StatusInternalServerError {
+		t.Fatalf("got delivery %v, want a pending delivery with one failed attempt", d)
+	}
+
+	if d.Error != "unexpected status code 500" {
+		t.Errorf("got error %q, want unexpected status code 500", d.Error)
+	}
+
+	if d.NextAttemptAt.Before(before.Add(webhookRetryDelay)) || d.NextAttemptAt.After(after.Add(webhookRetryDelay)) {
+		t.Errorf("got next attempt at %s, want a minute after the attempt", d.NextAttemptAt)
+	}
+
+	// deliveries are not attempted before they are due
+	deliver(t, client, sender)
+	if n := len(sender.sentTo("http://fail.test")); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+
+	sender.errs = map[string]error{"http://fail.test": errors.New("connection refused")}
+	for i := 1; i < maxWebhookAttempts; i++ {
+		makeDue(t, client)
+		deliver(t, client, sender)
+	}
+
+	d = deliveries(t, client, "http://fail.test")[0]
+	if d.Status != webhookdelivery.StatusFailed || d.Attempts != maxWebhookAttempts || d.Error != "connection refused" {
+		t.Errorf("got delivery %v, want it to have failed after %d attempts", d, maxWebhookAttempts)
+	}
+
+	makeDue(t, client)
+	deliver(t, client, sender)
+	if n := len(sender.sentTo("http://fail.test")); n != maxWebhookAttempts {
+		t.Errorf("got %d requests, want %d", n, maxWebhookAttempts)
+	}
+}
+
+func TestDeliverWebhooksStopsAtFailingWebhook(t *testing.T) {
+	client := newTestClient(t)
+	createWebhook(t, client, CreateWebhook{URL: "http://ok.test", Secret: "s3cret"})
+	createWebhook(t, client, CreateWebhook{URL: "http://fail.test", Secret: "s3cret"})
+
+	source := adapters.NewMemoryRoleSource(testRole("roles/viewer", "Viewer", "1", "a.r.get"))
+	runSync(t, client, source)
+	source.SetRoles(testRole("roles/viewer", "Viewer", "2", "a.r.get", "a.r.list"))
+	runSync(t, client, source)
+
+	sender := &fakeSender{errs: map[string]error{"http://fail.test": errors.New("timeout")}}
+	deliver(t, client, sender)
+
+	// the failing webhook does not hold up the other one
+	ok := deliveries(t, client, "http://ok.test")
+	for _, d := range ok {
+		if d.Status != webhookdelivery.StatusSucceeded {
+			t.Errorf("got delivery %d %s, want succeeded", d.ID, d.Status)
+		}
+	}
+
+	var changeSets []int
+	for _, r := range sender.sentTo("http://ok.test") {
+		var payload webhookPayload
+		if err := json.Unmarshal(r.body, &payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		changeSets = append(changeSets, payload.ChangeSet)
+	}
+
+	if len(changeSets) != 2 || changeSets[0] > changeSets[1] {
+		t.Errorf("got change sets %v delivered, want both in order", changeSets)
+	}
+
+	// the deliveries to the failing webhook stop at the first failure
+	failed := deliveries(t, client, "http://fail.test")
+	if len(failed) != 2 || failed[0].Attempts != 1 || failed[1].Attempts != 0 {
+		t.Errorf("got deliveries %v, want only the first to be attempted", failed)
+	}
+
+	// the second delivery is due but waits for the retry of the first
+	sender = &fakeSender{}
+	deliver(t, client, sender)
+	if n := len(sender.sentTo("http://fail.test")); n != 0 {
+		t.Errorf("got %d requests before the retry was due, want none", n)
+	}
+
+	makeDue(t, client)
+	deliver(t, client, sender)
+
+	changeSets = nil
+	for _, r := range sender.sentTo("http://fail.test") {
+		var payload webhookPayload
+		if err := json.Unmarshal(r.body, &payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		changeSets = append(changeSets, payload.ChangeSet)
+	}
+
+	if len(changeSets) != 2 || changeSets[0] > changeSets[1] {
+		t.Errorf("got change sets %v delivered after the retry, want both in order", changeSets)
+	}
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
+)
+
+// updatePermissionGrants opens a grant valid from now for every permission
+// a role gained and closes the grant of every permission a role lost since
+// the previous sync, so that the permissions of a role can be resolved at
+// any point in time. Deleted roles keep the grants of their permissions,
+// as they keep the permissions.
+func updatePermissionGrants(ctx context.Context, tx *ent.Tx, now time.Time) error {
+	rolePermissions, err := rolePermissionIDs(ctx, tx, nil)
+	if err != nil {
+		return err
+	}
+
+	current := map[rolePermission]bool{}
+	for r, permissions := range rolePermissions {
+		for _, p := range permissions {
+			current[rolePermission{role: r, permission: p}] = true
+		}
+	}
+
+	grants, err := tx.PermissionGrant.Query().
+		Where(permissiongrant.ValidToIsNil()).
+		WithRole().
+		WithPermission().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	open := make(map[rolePermission]bool, len(grants))
+	var closed []int
+	for _, g := range grants {
+		if g.Edges.Role != nil && g.Edges.Permission != nil {
+			key := rolePermission{role: g.Edges.Role.ID, permission: g.Edges.Permission.ID}
+			if current[key] && !open[key] {
+				open[key] = true
+				continue
+			}
+		}
+
+		closed = append(closed, g.ID)
+	}
+
+	var opened []*ent.PermissionGrantCreate
+	for key := range current {
+		if !open[key] {
+			opened = append(opened, tx.PermissionGrant.Create().
+				SetRoleID(key.role).
+				SetPermissionID(key.permission).
+				SetValidFrom(now))
+		}
+	}
+
+	err = inBatches(len(closed), func(start, end int) error {
+		return tx.PermissionGrant.Update().
+			Where(permissiongrant.IDIn(closed[start:end]...)).
+			SetValidTo(now).
+			Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = inBatches(len(opened), func(start, end int) error {
+		_, err := tx.PermissionGrant.CreateBulk(opened[start:end]...).Save(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("opened %d and closed %d permission grants\n", len(opened), len(closed))
+
+	return nil
+}
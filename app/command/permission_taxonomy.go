@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/permission"
+)
+
+// splitPermission splits a permission name of the form
+// <service>.<resource type>.<verb> into its parts. Names with fewer
+// segments have no resource type, and no verb if they have only one.
+func splitPermission(name string) (service, resourceType, verb string) {
+	segments := strings.Split(name, ".")
+	switch len(segments) {
+	case 1:
+		return segments[0], "", ""
+	case 2:
+		return segments[0], "", segments[1]
+	}
+
+	return segments[0], strings.Join(segments[1:len(segments)-1], "."), segments[len(segments)-1]
+}
+
+// updatePermissionTaxonomy links the permissions which are not linked to a
+// service yet to the service and resource type named by their name,
+// creating the services and resource types as needed.
+func updatePermissionTaxonomy(ctx context.Context, tx *ent.Tx) error {
+	perms, err := tx.Permission.Query().
+		Where(permission.Not(permission.HasService())).
+		All(ctx)
+	if err != nil || len(perms) == 0 {
+		return err
+	}
+
+	services, err := tx.Service.Query().WithResourceTypes().All(ctx)
+	if err != nil {
+		return err
+	}
+
+	serviceIDs := map[string]int{}
+	resourceTypeIDs := map[string]int{}
+	for _, s := range services {
+		serviceIDs[s.Name] = s.ID
+		for _, rt := range s.Edges.ResourceTypes {
+			resourceTypeIDs[s.Name+"."+rt.Name] = rt.ID
+		}
+	}
+
+	// permissions sharing a resource type and verb are updated together
+	type link struct {
+		service      string
+		resourceType string
+		verb         string
+	}
+
+	links := map[link][]int{}
+	for _, p := range perms {
+		serviceName, resourceTypeName, verb := splitPermission(p.Name)
+		if _, ok := serviceIDs[serviceName]; !ok {
+			fmt.Printf("creating service %s\n", serviceName)
+			s, err := tx.Service.Create().SetName(serviceName).Save(ctx)
+			if err != nil {
+				return err
+			}
+			serviceIDs[serviceName] = s.ID
+		}
+
+		l := link{service: serviceName, verb: verb}
+		if resourceTypeName != "" {
+			l.resourceType = serviceName + "." + resourceTypeName
+			if _, ok := resourceTypeIDs[l.resourceType]; !ok {
+				fmt.Printf("creating resource type %s\n", l.resourceType)
+				rt, err := tx.ResourceType.Create().
+					SetName(resourceTypeName).
+					SetServiceID(serviceIDs[serviceName]).
+					Save(ctx)
+				if err != nil {
+					return err
+				}
+				resourceTypeIDs[l.resourceType] = rt.ID
+			}
+		}
+
+		links[l] = append(links[l], p.ID)
+	}
+
+	for l, ids := range links {
+		update := tx.Permission.Update().
+			Where(permission.IDIn(ids...)).
+			SetVerb(l.verb).
+			SetServiceID(serviceIDs[l.service])
+		if l.resourceType != "" {
+			update = update.SetResourceTypeID(resourceTypeIDs[l.resourceType])
+		}
+
+		if _, err := update.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("linked %d permissions to their services\n", len(perms))
+
+	return nil
+}
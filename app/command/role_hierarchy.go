@@ -0,0 +1,139 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"sort"
+
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/role"
+)
+
+type permissionSet []uint64
+
+func (p permissionSet) subsetOf(o permissionSet) bool {
+	for i := range p {
+		if p[i]&^o[i] != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// updateRoleHierarchy links every role to the roles whose permissions are a
+// strict subset of its own. Only direct subsets are stored, i.e. the
+// transitive reduction of the containment relation. Roles without any
+// permissions and deleted roles are left out of the hierarchy.
+func updateRoleHierarchy(ctx context.Context, tx *ent.Tx) error {
+	roles, err := tx.Role.Query().
+		Where(role.DeletedAtIsNil()).
+		WithPermissions().
+		WithSubsets().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	position := make(map[int]int, len(roles))
+	index := map[int]int{}
+	for i, r := range roles {
+		position[r.ID] = i
+		for _, p := range r.Edges.Permissions {
+			if _, ok := index[p.ID]; !ok {
+				index[p.ID] = len(index)
+			}
+		}
+	}
+
+	sets := make([]permissionSet, len(roles))
+	sizes := make([]int, len(roles))
+	for i, r := range roles {
+		sets[i] = make(permissionSet, (len(index)+63)/64)
+		for _, p := range r.Edges.Permissions {
+			j := index[p.ID]
+			sets[i][j/64] |= 1 << (uint(j) % 64)
+		}
+
+		for _, w := range sets[i] {
+			sizes[i] += bits.OnesCount64(w)
+		}
+	}
+
+	// order roles by the size of their permission set so that only
+	// larger roles need to be checked for containment
+	order := make([]int, 0, len(roles))
+	for i := range roles {
+		if sizes[i] > 0 {
+			order = append(order, i)
+		}
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return sizes[order[a]] < sizes[order[b]]
+	})
+
+	supersets := make([]map[int]bool, len(roles))
+	for a, i := range order {
+		supersets[i] = map[int]bool{}
+		for _, j := range order[a+1:] {
+			if sizes[j] > sizes[i] && sets[i].subsetOf(sets[j]) {
+				supersets[i][j] = true
+			}
+		}
+	}
+
+	// a superset is direct if it is not a superset of another superset
+	subsets := make([]map[int]bool, len(roles))
+	for i := range roles {
+		subsets[i] = map[int]bool{}
+	}
+
+	for _, i := range order {
+		for j := range supersets[i] {
+			direct := true
+			for k := range supersets[i] {
+				if supersets[k][j] {
+					direct = false
+					break
+				}
+			}
+
+			if direct {
+				subsets[j][i] = true
+			}
+		}
+	}
+
+	updated := 0
+	for i, r := range roles {
+		existing := map[int]bool{}
+		var removed []int
+		for _, s := range r.Edges.Subsets {
+			existing[s.ID] = true
+			if !subsets[i][position[s.ID]] {
+				removed = append(removed, s.ID)
+			}
+		}
+
+		var added []int
+		for j := range subsets[i] {
+			if !existing[roles[j].ID] {
+				added = append(added, roles[j].ID)
+			}
+		}
+
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+
+		updated++
+		if err := tx.Role.UpdateOne(r).AddSubsetIDs(added...).RemoveSubsetIDs(removed...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("updated role hierarchy of %d roles\n", updated)
+
+	return nil
+}
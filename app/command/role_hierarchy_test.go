@@ -0,0 +1,112 @@
+package command
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/rosstimothy/iam/adapters"
+	"github.com/rosstimothy/iam/ent"
+)
+
+// hierarchy returns the names of the direct subsets of each role which
+// has any.
+func hierarchy(t *testing.T, client *ent.Client) map[string][]string {
+	t.Helper()
+
+	roles, err := client.Role.Query().WithSubsets().All(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get roles: %v", err)
+	}
+
+	subsets := map[string][]string{}
+	for _, r := range roles {
+		for _, s := range r.Edges.Subsets {
+			subsets[r.Name] = append(subsets[r.Name], s.Name)
+		}
+		sort.Strings(subsets[r.Name])
+	}
+
+	for name, s := range subsets {
+		if len(s) == 0 {
+			delete(subsets, name)
+		}
+	}
+
+	return subsets
+}
+
+func assertHierarchy(t *testing.T, got, want map[string][]string) {
+	t.Helper()
+
+	for name := range want {
+		assertStrings(t, name+" subsets", got[name], want[name])
+	}
+
+	for name := range got {
+		if _, ok := want[name]; !ok {
+			t.Errorf("%s subsets = %q, want none", name, got[name])
+		}
+	}
+}
+
+func TestUpdateRoleHierarchy(t *testing.T) {
+	client := newTestClient(t)
+	a := testRole("roles/a", "A", "1", "p.r.a")
+	b := testRole("roles/b", "B", "1", "p.r.a", "p.r.b")
+	// roles with the same permissions are not subsets of each other
+	b2 := testRole("roles/b2", "B2", "1", "p.r.a", "p.r.b")
+	c := testRole("roles/c", "C", "1", "p.r.a", "p.r.b", "p.r.c")
+	d := testRole("roles/d", "D", "1", "p.r.d")
+	source := adapters.NewMemoryRoleSource(
+		a, b, b2, c, d,
+		testRole("roles/e", "E", "1", "p.r.a", "p.r.d"),
+		// roles without permissions are left out
+		testRole("roles/empty", "Empty", "1"),
+	)
+
+	runSync(t, client, source)
+
+	// c only links its direct subsets b and b2, not a
+	assertHierarchy(t, hierarchy(t, client), map[string][]string{
+		"roles/b":  {"roles/a"},
+		"roles/b2": {"roles/a"},
+		"roles/c":  {"roles/b", "roles/b2"},
+		"roles/e":  {"roles/a", "roles/d"},
+	})
+
+	// once b and b2 are gone a becomes a direct subset of c, and the
+	// deleted roles are removed from the hierarchy
+	source.SetRoles(a, deletedRole(b, "2"), deletedRole(b2, "2"), c, d,
+		testRole("roles/e", "E", "2", "p.r.d", "p.r.e"),
+	)
+	runSync(t, client, source)
+
+	assertHierarchy(t, hierarchy(t, client), map[string][]string{
+		"roles/c": {"roles/a"},
+		"roles/e": {"roles/d"},
+	})
+
+	if r := getRole(t, client, "roles/b2"); r.DeletedAt == nil {
+		t.Errorf("roles/b2 is not deleted")
+	}
+}
+
+func TestPermissionSetSubsetOf(t *testing.T) {
+	tests := []struct {
+		p, o permissionSet
+		want bool
+	}{
+		{p: permissionSet{0b01}, o: permissionSet{0b11}, want: true},
+		{p: permissionSet{0b11}, o: permissionSet{0b11}, want: true},
+		{p: permissionSet{0b11}, o: permissionSet{0b01}, want: false},
+		{p: permissionSet{0, 0b1}, o: permissionSet{0b1, 0b1}, want: true},
+		{p: permissionSet{0b1, 0b1}, o: permissionSet{0b1, 0}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.p.subsetOf(tt.o); got != tt.want {
+			t.Errorf("%b.subsetOf(%b) = %t, want %t", tt.p, tt.o, got, tt.want)
+		}
+	}
+}
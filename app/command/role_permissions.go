@@ -0,0 +1,95 @@
+package command
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/role"
+)
+
+// rolePermission is a row of the join table of roles and their permissions.
+type rolePermission struct {
+	role       int
+	permission int
+}
+
+// rolePermissionIDs returns the ids of the permissions of the roles with
+// the given ids, or of every role if roles is nil, by the id of the role.
+func rolePermissionIDs(ctx context.Context, tx *ent.Tx, roles []int) (map[int][]int, error) {
+	ids := map[int][]int{}
+	load := func(preds ...*sql.Predicate) error {
+		selector := sql.Dialect(tx.Dialect()).
+			Select(role.PermissionsPrimaryKey...).
+			From(sql.Table(role.PermissionsTable))
+		for _, p := range preds {
+			selector.Where(p)
+		}
+
+		query, args := selector.Query()
+		rows, err := tx.Query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			var rp rolePermission
+			if err := rows.Scan(&rp.role, &rp.permission); err != nil {
+				return err
+			}
+			ids[rp.role] = append(ids[rp.role], rp.permission)
+		}
+
+		return rows.Err()
+	}
+
+	if roles == nil {
+		return ids, load()
+	}
+
+	return ids, inBatches(len(roles), func(start, end int) error {
+		batch := make([]interface{}, end-start)
+		for i, id := range roles[start:end] {
+			batch[i] = id
+		}
+
+		return load(sql.In(role.PermissionsPrimaryKey[0], batch...))
+	})
+}
+
+// addRolePermissions inserts the rows of the join table in batches.
+func addRolePermissions(ctx context.Context, tx *ent.Tx, added []rolePermission) error {
+	return inBatches(len(added), func(start, end int) error {
+		insert := sql.Dialect(tx.Dialect()).
+			Insert(role.PermissionsTable).
+			Columns(role.PermissionsPrimaryKey...)
+		for _, rp := range added[start:end] {
+			insert.Values(rp.role, rp.permission)
+		}
+
+		query, args := insert.Query()
+		return tx.Exec(ctx, query, args...)
+	})
+}
+
+// removeRolePermissions deletes the rows of the join table in batches.
+func removeRolePermissions(ctx context.Context, tx *ent.Tx, removed []rolePermission) error {
+	return inBatches(len(removed), func(start, end int) error {
+		preds := make([]*sql.Predicate, 0, end-start)
+		for _, rp := range removed[start:end] {
+			preds = append(preds, sql.And(
+				sql.EQ(role.PermissionsPrimaryKey[0], rp.role),
+				sql.EQ(role.PermissionsPrimaryKey[1], rp.permission),
+			))
+		}
+
+		query, args := sql.Dialect(tx.Dialect()).
+			Delete(role.PermissionsTable).
+			Where(sql.Or(preds...)).
+			Query()
+		return tx.Exec(ctx, query, args...)
+	})
+}
@@ -0,0 +1,114 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolerevision"
+)
+
+// latestRevision matches the latest revision of each role.
+func latestRevision() predicate.RoleRevision {
+	return func(s *sql.Selector) {
+		t := sql.Table(rolerevision.Table).As("latest")
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(rolerevision.FieldRevision)).WriteOp(sql.OpEQ).Nested(func(b *sql.Builder) {
+				b.Join(sql.Select(sql.Max(t.C(rolerevision.FieldRevision))).
+					From(t).
+					Where(sql.ColumnsEQ(t.C(rolerevision.RoleColumn), s.C(rolerevision.RoleColumn))))
+			})
+		}))
+	}
+}
+
+// latestRevisions returns the latest revision of the roles with the given
+// ids which have any by the id of the role.
+func latestRevisions(ctx context.Context, tx *ent.Tx, roles []int) (map[int]*ent.RoleRevision, error) {
+	latest := make(map[int]*ent.RoleRevision, len(roles))
+	return latest, inBatches(len(roles), func(start, end int) error {
+		revisions, err := tx.RoleRevision.Query().
+			Where(
+				latestRevision(),
+				rolerevision.HasRoleWith(role.IDIn(roles[start:end]...)),
+			).
+			WithRole().
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, rev := range revisions {
+			latest[rev.Edges.Role.ID] = rev
+		}
+
+		return nil
+	})
+}
+
+// newRevision returns the creation of r with the named permissions as the
+// revision following latest, the latest revision of r if any, or nil if r
+// is unchanged since latest.
+func newRevision(tx *ent.Tx, r *ent.Role, latest *ent.RoleRevision, permissions []string, now time.Time) *ent.RoleRevisionCreate {
+	perms := make([]string, 0, len(permissions))
+	seen := make(map[string]bool, len(permissions))
+	for _, p := range permissions {
+		if !seen[p] {
+			seen[p] = true
+			perms = append(perms, p)
+		}
+	}
+	sort.Strings(perms)
+
+	revision := 1
+	if latest != nil {
+		if sameRevision(latest, r, perms) {
+			return nil
+		}
+		revision = latest.Revision + 1
+	}
+
+	return tx.RoleRevision.Create().
+		SetRoleID(r.ID).
+		SetRevision(revision).
+		SetTitle(r.Title).
+		SetDescription(r.Description).
+		SetStage(rolerevision.Stage(r.Stage)).
+		SetEtag(r.Etag).
+		SetPermissions(perms).
+		SetDeleted(r.DeletedAt != nil).
+		SetCreatedAt(now)
+}
+
+// createRevisions stores the revisions in batches.
+func createRevisions(ctx context.Context, tx *ent.Tx, revisions []*ent.RoleRevisionCreate) error {
+	return inBatches(len(revisions), func(start, end int) error {
+		_, err := tx.RoleRevision.CreateBulk(revisions[start:end]...).Save(ctx)
+		return err
+	})
+}
+
+func sameRevision(rev *ent.RoleRevision, r *ent.Role, permissions []string) bool {
+	if rev.Title != r.Title ||
+		rev.Description != r.Description ||
+		rev.Stage.String() != r.Stage.String() ||
+		!bytes.Equal(rev.Etag, r.Etag) ||
+		rev.Deleted != (r.DeletedAt != nil) ||
+		len(rev.Permissions) != len(permissions) {
+		return false
+	}
+
+	for i := range permissions {
+		if rev.Permissions[i] != permissions[i] {
+			return false
+		}
+	}
+
+	return true
+}
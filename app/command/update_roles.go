@@ -1,35 +1,63 @@
 package command
 
 import (
+	"bytes"
 	"context"
-	"errors"
 	"fmt"
+	"strings"
+	"time"
 
-	admin "cloud.google.com/go/iam/admin/apiv1"
 	adminpb "google.golang.org/genproto/googleapis/iam/admin/v1"
 
 	"github.com/rosstimothy/iam/ent"
-	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/predicate"
 	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolechange"
 )
 
-type UpdateRoles struct{}
+type UpdateRoles struct {
+	// Parents are the organizations/<id> and projects/<id> whose custom
+	// roles are synced in addition to the predefined roles.
+	Parents []string
+}
+
+// RoleSource provides the upstream roles which are synced into the local db.
+type RoleSource interface {
+	// ListRoles returns all roles defined by parent, including deleted ones,
+	// with their included permissions populated. Roles listed without their
+	// permissions, e.g. exported by `gcloud iam roles list`, have nil included
+	// permissions, roles without any permissions an empty slice. An empty
+	// parent lists the predefined roles.
+	ListRoles(ctx context.Context, parent string) ([]*adminpb.Role, error)
+}
 
 type UpdateRolesHandler struct {
 	client *ent.Client
+	source RoleSource
 }
 
-func NewUpdateRolesHandler(client *ent.Client) *UpdateRolesHandler {
+func NewUpdateRolesHandler(client *ent.Client, source RoleSource) *UpdateRolesHandler {
 	if client == nil {
 		panic("nil client")
 	}
 
-	return &UpdateRolesHandler{client: client}
+	if source == nil {
+		panic("nil source")
+	}
+
+	return &UpdateRolesHandler{client: client, source: source}
 }
 
-var (
-	notFound *ent.NotFoundError
-)
+// syncCounts are the number of roles a sync created, updated, deleted and
+// skipped because they were unchanged.
+type syncCounts struct {
+	created, updated, deleted, unchanged int
+}
+
+// written returns the number of roles a sync wrote.
+func (c syncCounts) written() int {
+	return c.created + c.updated + c.deleted
+}
 
 func (l *UpdateRolesHandler) Handle(ctx context.Context, cmd UpdateRoles) (err error) {
 	defer func() {
@@ -41,13 +69,26 @@ func (l *UpdateRolesHandler) Handle(ctx context.Context, cmd UpdateRoles) (err e
 		fmt.Println("completed updating roles")
 	}()
 
-	fmt.Println("fetching roles")
-	roles, err := fetchRoles(ctx)
-	if err != nil {
-		return err
+	parents := append([]string{""}, cmd.Parents...)
+	scopes := make([]role.Scope, len(parents))
+	for i, parent := range parents {
+		scope, err := ParentScope(parent)
+		if err != nil {
+			return err
+		}
+		scopes[i] = scope
 	}
 
-	fmt.Printf("found %d roles\n", len(roles))
+	roles := make([][]*adminpb.Role, len(parents))
+	for i, parent := range parents {
+		fmt.Printf("fetching %s roles %s\n", scopes[i], parent)
+		roles[i], err = l.source.ListRoles(ctx, parent)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("found %d roles\n", len(roles[i]))
+	}
 
 	tx, err := l.client.Tx(ctx)
 	if err != nil {
@@ -56,30 +97,50 @@ func (l *UpdateRolesHandler) Handle(ctx context.Context, cmd UpdateRoles) (err e
 
 	defer tx.Rollback()
 
-	for _, iamRole := range roles {
-		if iamRole.Deleted {
-			_, err := tx.Role.Delete().Where(role.Name(iamRole.Name)).Exec(ctx)
-			if err != nil {
-				fmt.Println(err.Error())
-			}
-			continue
+	permissions, err := upsertPermissions(ctx, tx, roles)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	syncer := newRoleSync(tx, permissions, now)
+	for i := range parents {
+		if err := syncer.syncRoles(ctx, scopes[i], parents[i], roles[i]); err != nil {
+			return err
 		}
+	}
 
-		r, err := tx.Role.Query().Where(role.Name(iamRole.Name)).WithPermissions().Only(ctx)
-		if err != nil {
-			if !errors.As(err, &notFound) {
-				return err
-			}
+	if err := syncer.flush(ctx); err != nil {
+		return err
+	}
 
-			fmt.Printf("creating role %s\n", iamRole.Name)
-			if err := createRole(ctx, tx, iamRole); err != nil {
-				return err
-			}
-			continue
+	counts := syncer.counts
+	fmt.Printf("%d roles created, %d updated, %d deleted, %d unchanged\n", counts.created, counts.updated, counts.deleted, counts.unchanged)
+
+	if err := recordChanges(ctx, tx, syncer.changes, now); err != nil {
+		return err
+	}
+
+	if err := updatePermissionTaxonomy(ctx, tx); err != nil {
+		return err
+	}
+
+	// the permissions of the roles and therefore their grants and hierarchy
+	// only change when roles are written, but the grants of a db synced
+	// before grants were recorded are opened by the next sync
+	hasGrants, err := tx.PermissionGrant.Query().Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	if counts.written() > 0 || !hasGrants {
+		if err := updatePermissionGrants(ctx, tx, now); err != nil {
+			return err
 		}
+	}
 
-		fmt.Printf("updating role %s\n", iamRole.Name)
-		if err := updateRole(ctx, tx, r, iamRole); err != nil {
+	if counts.written() > 0 {
+		if err := updateRoleHierarchy(ctx, tx); err != nil {
 			return err
 		}
 	}
@@ -87,134 +148,455 @@ func (l *UpdateRolesHandler) Handle(ctx context.Context, cmd UpdateRoles) (err e
 	return tx.Commit()
 }
 
-func newPermissions(ctx context.Context, tx *ent.Tx, iamRole *adminpb.Role) ([]*ent.Permission, error) {
-	permissions, err := tx.Permission.
-		Query().
-		Where(permission.NameIn(iamRole.IncludedPermissions...)).
-		All(ctx)
+// ParentScope returns the scope of the roles defined by parent.
+func ParentScope(parent string) (role.Scope, error) {
+	if parent == "" {
+		return role.ScopePredefined, nil
+	}
+
+	parts := strings.Split(parent, "/")
+	if len(parts) == 2 && parts[1] != "" {
+		switch parts[0] {
+		case "organizations":
+			return role.ScopeOrganization, nil
+		case "projects":
+			return role.ScopeProject, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid parent %q: must be organizations/<id> or projects/<id>", parent)
+}
+
+// roleSync syncs the roles of a transaction. The roles are created and
+// deleted in batches, their permissions and revisions are written in
+// batches by flush.
+type roleSync struct {
+	tx  *ent.Tx
+	now time.Time
+	// permissions are all permissions by name and by id.
+	permissions     map[string]*ent.Permission
+	permissionsByID map[int]*ent.Permission
+
+	counts    syncCounts
+	changes   []*ent.RoleChangeCreate
+	added     []rolePermission
+	removed   []rolePermission
+	revisions []*ent.RoleRevisionCreate
+}
+
+func newRoleSync(tx *ent.Tx, permissions map[string]*ent.Permission, now time.Time) *roleSync {
+	byID := make(map[int]*ent.Permission, len(permissions))
+	for _, p := range permissions {
+		byID[p.ID] = p
+	}
+
+	return &roleSync{
+		tx:              tx,
+		now:             now,
+		permissions:     permissions,
+		permissionsByID: byID,
+	}
+}
+
+// syncRoles creates, updates and deletes the roles defined by parent to
+// match roles and collects the changes of the roles.
+// Roles whose etag did not change are skipped.
+func (s *roleSync) syncRoles(ctx context.Context, scope role.Scope, parent string, roles []*adminpb.Role) error {
+	existing, err := s.tx.Role.Query().Where(role.Parent(parent)).All(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var newPermissions []*ent.PermissionCreate
+	byName := make(map[string]*ent.Role, len(existing))
+	for _, r := range existing {
+		byName[r.Name] = r
+	}
 
-	for i, includedPermission := range iamRole.IncludedPermissions {
-		idx := -1
-		for _, existingPermission := range permissions {
-			if existingPermission.Name == includedPermission {
-				idx = i
-				break
-			}
+	// roles synced from exports which didn't include their permissions
+	// are updated once their permissions are included
+	hasPermissions, err := roleIDs(ctx, s.tx, role.Parent(parent), role.HasPermissions())
+	if err != nil {
+		return err
+	}
+
+	// roles synced before revisions were recorded are updated once to
+	// record their first revision
+	hasRevisions, err := roleIDs(ctx, s.tx, role.Parent(parent), role.HasRevisions())
+	if err != nil {
+		return err
+	}
+
+	var created []*adminpb.Role
+	var written []*ent.Role
+	writes := map[int]*adminpb.Role{}
+	for _, iamRole := range roles {
+		r, ok := byName[iamRole.Name]
+		if !ok {
+			fmt.Printf("creating role %s\n", iamRole.Name)
+			s.counts.created++
+			created = append(created, iamRole)
+			continue
 		}
 
-		if idx < 0 {
-			fmt.Printf("creating permission %s\n", includedPermission)
-			newPermissions = append(newPermissions, tx.Permission.Create().SetName(includedPermission))
+		if iamRole.Deleted && r.DeletedAt != nil ||
+			hasRevisions[r.ID] && unchanged(r, iamRole, hasPermissions[r.ID]) {
+			s.counts.unchanged++
+			continue
 		}
+
+		written = append(written, r)
+		writes[r.ID] = iamRole
 	}
 
-	if len(newPermissions) > 0 {
-		p, err := tx.Permission.CreateBulk(newPermissions...).Save(ctx)
-		if err != nil {
-			return nil, err
+	if err := s.createRoles(ctx, scope, parent, created); err != nil {
+		return err
+	}
+
+	ids := make([]int, len(written))
+	for i, r := range written {
+		ids[i] = r.ID
+	}
+
+	permissionIDs, err := rolePermissionIDs(ctx, s.tx, ids)
+	if err != nil {
+		return err
+	}
+
+	latest, err := latestRevisions(ctx, s.tx, ids)
+	if err != nil {
+		return err
+	}
+
+	var deleted []*ent.Role
+	for _, r := range written {
+		if rev, ok := latest[r.ID]; ok {
+			r.Edges.Revisions = []*ent.RoleRevision{rev}
+		}
+
+		r.Edges.Permissions = make([]*ent.Permission, len(permissionIDs[r.ID]))
+		for i, id := range permissionIDs[r.ID] {
+			r.Edges.Permissions[i] = s.permissionsByID[id]
+		}
+
+		iamRole := writes[r.ID]
+		if iamRole.Deleted {
+			fmt.Printf("deleting role %s\n", iamRole.Name)
+			s.counts.deleted++
+			deleted = append(deleted, r)
+			continue
 		}
 
-		return append(permissions, p...), nil
+		if r.DeletedAt != nil {
+			fmt.Printf("undeleting role %s\n", iamRole.Name)
+		} else {
+			fmt.Printf("updating role %s\n", iamRole.Name)
+		}
+		s.counts.updated++
+
+		if err := s.updateRole(ctx, r, iamRole); err != nil {
+			return err
+		}
 	}
 
-	return permissions, nil
+	return s.deleteRoles(ctx, deleted)
 }
 
-func createRole(ctx context.Context, tx *ent.Tx, iamRole *adminpb.Role) error {
-	perms, err := newPermissions(ctx, tx, iamRole)
-	if err != nil {
+// flush writes the permissions and revisions of the synced roles.
+func (s *roleSync) flush(ctx context.Context) error {
+	if err := removeRolePermissions(ctx, s.tx, s.removed); err != nil {
 		return err
 	}
 
-	_, err = tx.Role.Create().
-		SetName(iamRole.Name).
-		SetTitle(iamRole.Title).
-		SetDescription(iamRole.Description).
-		SetEtag(iamRole.Etag).
-		SetStage(int(iamRole.Stage)).
-		AddPermissions(perms...).
-		Save(ctx)
+	if err := addRolePermissions(ctx, s.tx, s.added); err != nil {
+		return err
+	}
+
+	return createRevisions(ctx, s.tx, s.revisions)
+}
+
+// revise records r with the named permissions as the next revision of r,
+// unless r is unchanged since its latest revision.
+func (s *roleSync) revise(r *ent.Role, permissions []string) {
+	var latest *ent.RoleRevision
+	if len(r.Edges.Revisions) > 0 {
+		latest = r.Edges.Revisions[0]
+	}
+
+	if revision := newRevision(s.tx, r, latest, permissions, s.now); revision != nil {
+		s.revisions = append(s.revisions, revision)
+	}
+}
+
+// updatePermissions adds and removes the permissions of the role with
+// the given id.
+func (s *roleSync) updatePermissions(id int, added, removed []*ent.Permission) {
+	for _, p := range added {
+		s.added = append(s.added, rolePermission{role: id, permission: p.ID})
+	}
+
+	for _, p := range removed {
+		s.removed = append(s.removed, rolePermission{role: id, permission: p.ID})
+	}
+}
+
+// permissionsIncluded reports whether iamRole was listed with its permissions.
+func permissionsIncluded(iamRole *adminpb.Role) bool {
+	return iamRole.IncludedPermissions != nil
+}
 
-	return err
+// unchanged reports whether r already matches iamRole: neither is deleted,
+// iamRole has the etag r was last synced with and r has permissions unless
+// iamRole includes none.
+func unchanged(r *ent.Role, iamRole *adminpb.Role, hasPermissions bool) bool {
+	if iamRole.Deleted || r.DeletedAt != nil {
+		return false
+	}
+
+	if len(iamRole.IncludedPermissions) > 0 && !hasPermissions {
+		return false
+	}
+
+	return len(iamRole.Etag) > 0 && bytes.Equal(r.Etag, iamRole.Etag)
 }
 
-func removedPermissions(ctx context.Context, tx *ent.Tx, r *ent.Role, iamRole *adminpb.Role) ([]*ent.Permission, error) {
+// roleIDs returns the ids of the roles matching preds.
+func roleIDs(ctx context.Context, tx *ent.Tx, preds ...predicate.Role) (map[int]bool, error) {
+	ids, err := tx.Role.Query().Where(preds...).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	var removedPermissions []*ent.Permission
+	set := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+
+	return set, nil
+}
 
-	for i, existingPermission := range r.Edges.Permissions {
-		idx := -1
-		for _, includedPermission := range iamRole.IncludedPermissions {
-			if existingPermission.Name == includedPermission {
-				idx = i
-				break
+// upsertPermissions returns every permission by name, creating the
+// permissions included by roles which don't exist yet in batches.
+func upsertPermissions(ctx context.Context, tx *ent.Tx, roles [][]*adminpb.Role) (map[string]*ent.Permission, error) {
+	existing, err := tx.Permission.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make(map[string]*ent.Permission, len(existing))
+	for _, p := range existing {
+		permissions[p.Name] = p
+	}
+
+	var created []*ent.PermissionCreate
+	for _, parentRoles := range roles {
+		for _, iamRole := range parentRoles {
+			for _, name := range iamRole.IncludedPermissions {
+				if _, ok := permissions[name]; ok {
+					continue
+				}
+
+				fmt.Printf("creating permission %s\n", name)
+				permissions[name] = nil
+				created = append(created, tx.Permission.Create().SetName(name))
 			}
 		}
+	}
 
-		if idx < 0 {
-			fmt.Printf("removing permission %s\n", existingPermission.Name)
-			removedPermissions = append(removedPermissions, existingPermission)
+	err = inBatches(len(created), func(start, end int) error {
+		perms, err := tx.Permission.CreateBulk(created[start:end]...).Save(ctx)
+		for _, p := range perms {
+			permissions[p.Name] = p
 		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return removedPermissions, nil
+	return permissions, nil
 }
 
-func updateRole(ctx context.Context, tx *ent.Tx, r *ent.Role, iamRole *adminpb.Role) error {
+// diffPermissions returns the permissions included by iamRole which are not
+// in existing and the permissions in existing which iamRole doesn't include.
+func diffPermissions(permissions map[string]*ent.Permission, existing []*ent.Permission, iamRole *adminpb.Role) (added, removed []*ent.Permission) {
+	included := make(map[string]bool, len(iamRole.IncludedPermissions))
+	for _, name := range iamRole.IncludedPermissions {
+		included[name] = true
+	}
 
-	newPerms, err := newPermissions(ctx, tx, iamRole)
-	if err != nil {
-		return err
+	has := make(map[string]bool, len(existing))
+	for _, p := range existing {
+		has[p.Name] = true
+		if !included[p.Name] {
+			removed = append(removed, p)
+		}
+	}
+
+	for _, name := range iamRole.IncludedPermissions {
+		if !has[name] {
+			has[name] = true
+			added = append(added, permissions[name])
+		}
 	}
 
-	removedPerms, err := removedPermissions(ctx, tx, r, iamRole)
+	return added, removed
+}
+
+// createRoles creates the roles in batches, as tombstones deleted at now if
+// they are deleted, records their first revisions and the changes adding
+// them. Roles which are deleted by the time they are first synced were
+// never added.
+func (s *roleSync) createRoles(ctx context.Context, scope role.Scope, parent string, roles []*adminpb.Role) error {
+	creates := make([]*ent.RoleCreate, len(roles))
+	for i, iamRole := range roles {
+		creates[i] = s.tx.Role.Create().
+			SetName(iamRole.Name).
+			SetTitle(iamRole.Title).
+			SetDescription(iamRole.Description).
+			SetEtag(iamRole.Etag).
+			SetStage(role.Stage(iamRole.Stage.String())).
+			SetScope(scope).
+			SetParent(parent)
+		if iamRole.Deleted {
+			creates[i].SetDeletedAt(s.now)
+		}
+	}
+
+	return inBatches(len(creates), func(start, end int) error {
+		created, err := s.tx.Role.CreateBulk(creates[start:end]...).Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i, r := range created {
+			iamRole := roles[start+i]
+			perms, _ := diffPermissions(s.permissions, nil, iamRole)
+			s.updatePermissions(r.ID, perms, nil)
+			s.revise(r, iamRole.IncludedPermissions)
+
+			if iamRole.Deleted {
+				continue
+			}
+
+			s.changes = append(s.changes, s.tx.RoleChange.Create().
+				SetRole(r.Name).
+				SetKind(rolechange.KindAdded).
+				SetTitle(r.Title).
+				SetStage(rolechange.Stage(r.Stage)).
+				SetPermissionsAdded(permissionNames(perms)))
+		}
+
+		return nil
+	})
+}
+
+// deleteRoles deletes the roles in batches and records their revisions and
+// the changes deleting them. The tombstones keep the last known permissions
+// of the roles but are not part of the role hierarchy.
+func (s *roleSync) deleteRoles(ctx context.Context, roles []*ent.Role) error {
+	ids := make([]int, len(roles))
+	for i, r := range roles {
+		ids[i] = r.ID
+	}
+
+	err := inBatches(len(ids), func(start, end int) error {
+		return s.tx.Role.Update().
+			Where(role.IDIn(ids[start:end]...)).
+			SetDeletedAt(s.now).
+			ClearSubsets().
+			ClearSupersets().
+			Exec(ctx)
+	})
 	if err != nil {
 		return err
 	}
 
-	_, err = r.Update().
+	for _, r := range roles {
+		deleted := *r
+		deleted.DeletedAt = &s.now
+		s.revise(&deleted, permissionNames(r.Edges.Permissions))
+
+		s.changes = append(s.changes, s.tx.RoleChange.Create().
+			SetRole(r.Name).
+			SetKind(rolechange.KindDeleted).
+			SetTitle(r.Title).
+			SetStage(rolechange.Stage(r.Stage)))
+	}
+
+	return nil
+}
+
+// updateRole updates r to match iamRole, restoring r if it was deleted,
+// records a revision if r changed and the change of its title, stage or
+// permissions, if any. The permissions of r are left alone if iamRole was
+// listed without them.
+func (s *roleSync) updateRole(ctx context.Context, r *ent.Role, iamRole *adminpb.Role) error {
+	var newPerms, removedPerms []*ent.Permission
+	revised := permissionNames(r.Edges.Permissions)
+	if permissionsIncluded(iamRole) {
+		newPerms, removedPerms = diffPermissions(s.permissions, r.Edges.Permissions, iamRole)
+		revised = iamRole.IncludedPermissions
+	}
+
+	for _, p := range removedPerms {
+		fmt.Printf("removing permission %s\n", p.Name)
+	}
+
+	update := r.Update()
+	if r.DeletedAt != nil {
+		update.ClearDeletedAt()
+	}
+
+	updated, err := update.
 		SetTitle(iamRole.Title).
 		SetDescription(iamRole.Description).
 		SetEtag(iamRole.Etag).
-		SetStage(int(iamRole.Stage)).
-		RemovePermissions(removedPerms...).
-		AddPermissions(newPerms...).
+		SetStage(role.Stage(iamRole.Stage.String())).
 		Save(ctx)
+	if err != nil {
+		return err
+	}
 
-	return err
-}
+	s.updatePermissions(r.ID, newPerms, removedPerms)
+	updated.Edges.Revisions = r.Edges.Revisions
+	s.revise(updated, revised)
 
-func fetchRoles(ctx context.Context) ([]*adminpb.Role, error) {
-	c, err := admin.NewIamClient(ctx)
-	if err != nil {
-		return nil, err
+	change := s.tx.RoleChange.Create().
+		SetRole(r.Name).
+		SetKind(rolechange.KindUpdated).
+		SetTitle(updated.Title).
+		SetStage(rolechange.Stage(updated.Stage))
+	changed := false
+
+	if r.DeletedAt != nil {
+		change.SetKind(rolechange.KindUndeleted)
+		changed = true
 	}
 
-	token := ""
-	var roles []*adminpb.Role
-	for {
-		resp, err := c.ListRoles(ctx, &adminpb.ListRolesRequest{
-			Parent:      "",
-			PageToken:   token,
-			View:        adminpb.RoleView_FULL,
-			ShowDeleted: true,
-		})
-		if err != nil {
-			return nil, err
-		}
+	if r.Title != updated.Title {
+		change.SetPreviousTitle(r.Title)
+		changed = true
+	}
 
-		token = resp.NextPageToken
-		roles = append(roles, resp.Roles...)
+	if r.Stage != updated.Stage {
+		change.SetPreviousStage(rolechange.PreviousStage(r.Stage))
+		changed = true
+	}
 
-		if token == "" {
-			break
-		}
+	if len(newPerms) > 0 {
+		change.SetPermissionsAdded(permissionNames(newPerms))
+		changed = true
+	}
+
+	if len(removedPerms) > 0 {
+		change.SetPermissionsRemoved(permissionNames(removedPerms))
+		changed = true
+	}
+
+	if changed {
+		s.changes = append(s.changes, change)
 	}
 
-	return roles, nil
+	return nil
 }
@@ -0,0 +1,502 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	adminpb "google.golang.org/genproto/googleapis/iam/admin/v1"
+
+	"github.com/rosstimothy/iam/adapters"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/changeset"
+	"github.com/rosstimothy/iam/ent/enttest"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
+	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolechange"
+	"github.com/rosstimothy/iam/ent/rolerevision"
+)
+
+// newTestClient returns a client of an in memory db private to the test.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", name))
+	t.Cleanup(func() { client.Close() })
+
+	return client
+}
+
+func testRole(name, title, etag string, permissions ...string) *adminpb.Role {
+	return &adminpb.Role{
+		Name:                name,
+		Title:               title,
+		Etag:                []byte(etag),
+		Stage:               adminpb.Role_GA,
+		IncludedPermissions: permissions,
+	}
+}
+
+func deletedRole(r *adminpb.Role, etag string) *adminpb.Role {
+	return &adminpb.Role{
+		Name:                r.Name,
+		Title:               r.Title,
+		Etag:                []byte(etag),
+		Stage:               r.Stage,
+		IncludedPermissions: r.IncludedPermissions,
+		Deleted:             true,
+	}
+}
+
+// runSync syncs the roles of source into the db, failing the test on error.
+func runSync(t *testing.T, client *ent.Client, source RoleSource, parents ...string) {
+	t.Helper()
+
+	err := NewUpdateRolesHandler(client, source).Handle(context.Background(), UpdateRoles{Parents: parents})
+	if err != nil {
+		t.Fatalf("failed to update roles: %v", err)
+	}
+}
+
+func getRole(t *testing.T, client *ent.Client, name string) *ent.Role {
+	t.Helper()
+
+	r, err := client.Role.Query().Where(role.Name(name)).WithPermissions().Only(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get role %s: %v", name, err)
+	}
+
+	return r
+}
+
+func rolePermissions(t *testing.T, client *ent.Client, name string) []string {
+	t.Helper()
+
+	return permissionNames(getRole(t, client, name).Edges.Permissions)
+}
+
+func revisions(t *testing.T, client *ent.Client, name string) []*ent.RoleRevision {
+	t.Helper()
+
+	revs, err := client.RoleRevision.Query().
+		Where(rolerevision.HasRoleWith(role.Name(name))).
+		Order(ent.Asc(rolerevision.FieldRevision)).
+		All(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get revisions of %s: %v", name, err)
+	}
+
+	return revs
+}
+
+// grants returns the permissions of the open and the closed grants of a role.
+func grants(t *testing.T, client *ent.Client, name string) (open, closed []string) {
+	t.Helper()
+
+	gs, err := client.PermissionGrant.Query().
+		Where(permissiongrant.HasRoleWith(role.Name(name))).
+		WithPermission().
+		All(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get grants of %s: %v", name, err)
+	}
+
+	for _, g := range gs {
+		if g.ValidTo == nil {
+			open = append(open, g.Edges.Permission.Name)
+		} else {
+			closed = append(closed, g.Edges.Permission.Name)
+		}
+	}
+	sort.Strings(open)
+	sort.Strings(closed)
+
+	return open, closed
+}
+
+// changeSets returns the changes of each change set, oldest first,
+// ordered by role.
+func changeSets(t *testing.T, client *ent.Client) [][]*ent.RoleChange {
+	t.Helper()
+
+	sets, err := client.ChangeSet.Query().
+		WithChanges(func(q *ent.RoleChangeQuery) {
+			q.Order(ent.Asc(rolechange.FieldRole))
+		}).
+		Order(ent.Asc(changeset.FieldID)).
+		All(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get change sets: %v", err)
+	}
+
+	changes := make([][]*ent.RoleChange, len(sets))
+	for i, s := range sets {
+		changes[i] = s.Edges.Changes
+	}
+
+	return changes
+}
+
+func assertStrings(t *testing.T, what string, got, want []string) {
+	t.Helper()
+
+	if len(got) == 0 && len(want) == 0 {
+		return
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %q, want %q", what, got, want)
+	}
+}
+
+func TestUpdateRolesWithMemoryRoleSource(t *testing.T) {
+	client := newTestClient(t)
+	source := adapters.NewMemoryRoleSource(
+		testRole("roles/viewer", "Viewer", "1", "a.r.get", "b.r.get"),
+		testRole("roles/editor", "Editor", "1", "a.r.get", "a.r.set"),
+	)
+	runSync(t, client, source)
+
+	assertStrings(t, "viewer permissions", rolePermissions(t, client, "roles/viewer"), []string{"a.r.get", "b.r.get"})
+	assertStrings(t, "editor permissions", rolePermissions(t, client, "roles/editor"), []string{"a.r.get", "a.r.set"})
+
+	// the next sync picks up the roles the source returns by then
+	source.SetRoles(
+		testRole("roles/viewer", "Viewer", "2", "a.r.get"),
+		testRole("roles/editor", "Editor", "1", "a.r.get", "a.r.set"),
+	)
+	runSync(t, client, source)
+
+	assertStrings(t, "viewer permissions", rolePermissions(t, client, "roles/viewer"), []string{"a.r.get"})
+}
+
+func TestUpdateRolesCreatesRoles(t *testing.T) {
+	client := newTestClient(t)
+	source := adapters.NewMemoryRoleSource(
+		testRole("roles/viewer", "Viewer", "1", "b.r.get", "a.r.get"),
+		testRole("roles/editor", "Editor", "1", "a.r.get", "a.r.set", "b.r.get"),
+		// roles deleted before they are first synced are kept as tombstones
+		deletedRole(testRole("roles/gone", "Gone", "1", "a.r.get"), "1"),
+	)
+
+	runSync(t, client, source)
+
+	assertStrings(t, "viewer permissions", rolePermissions(t, client, "roles/viewer"), []string{"a.r.get", "b.r.get"})
+	assertStrings(t, "editor permissions", rolePermissions(t, client, "roles/editor"), []string{"a.r.get", "a.r.set", "b.r.get"})
+
+	if gone := getRole(t, client, "roles/gone"); gone.DeletedAt == nil {
+		t.Errorf("roles/gone is not deleted")
+	}
+
+	revs := revisions(t, client, "roles/viewer")
+	if len(revs) != 1 || revs[0].Revision != 1 || revs[0].Title != "Viewer" {
+		t.Fatalf("unexpected revisions of roles/viewer: %v", revs)
+	}
+	assertStrings(t, "revision permissions", revs[0].Permissions, []string{"a.r.get", "b.r.get"})
+
+	open, closed := grants(t, client, "roles/viewer")
+	assertStrings(t, "open grants", open, []string{"a.r.get", "b.r.get"})
+	assertStrings(t, "closed grants", closed, nil)
+
+	sets := changeSets(t, client)
+	if len(sets) != 1 || len(sets[0]) != 2 {
+		t.Fatalf("got change sets %v, want one with 2 changes", sets)
+	}
+
+	editor := sets[0][0]
+	if editor.Role != "roles/editor" || editor.Kind != rolechange.KindAdded {
+		t.Errorf("got change %s %s, want roles/editor added", editor.Role, editor.Kind)
+	}
+	assertStrings(t, "permissions added", editor.PermissionsAdded, []string{"a.r.get", "a.r.set", "b.r.get"})
+}
+
+func TestUpdateRolesUpdatesRoles(t *testing.T) {
+	client := newTestClient(t)
+	source := adapters.NewMemoryRoleSource(testRole("roles/viewer", "Viewer", "1", "a.r.get", "a.r.list"))
+	runSync(t, client, source)
+
+	updated := testRole("roles/viewer", "Reader", "2", "a.r.get", "b.r.get")
+	updated.Stage = adminpb.Role_DEPRECATED
+	source.SetRoles(updated)
+	runSync(t, client, source)
+
+	r := getRole(t, client, "roles/viewer")
+	if r.Title != "Reader" || r.Stage != role.StageDEPRECATED || string(r.Etag) != "2" {
+		t.Errorf("got title %q, stage %s and etag %q, want Reader, DEPRECATED and 2", r.Title, r.Stage, r.Etag)
+	}
+	assertStrings(t, "permissions", permissionNames(r.Edges.Permissions), []string{"a.r.get", "b.r.get"})
+
+	if revs := revisions(t, client, "roles/viewer"); len(revs) != 2 || revs[1].Revision != 2 || revs[1].Title != "Reader" {
+		t.Errorf("unexpected revisions: %v", revs)
+	}
+
+	open, closed := grants(t, client, "roles/viewer")
+	assertStrings(t, "open grants", open, []string{"a.r.get", "b.r.get"})
+	assertStrings(t, "closed grants", closed, []string{"a.r.list"})
+
+	sets := changeSets(t, client)
+	if len(sets) != 2 || len(sets[1]) != 1 {
+		t.Fatalf("got change sets %v, want a second one with 1 change", sets)
+	}
+
+	c := sets[1][0]
+	if c.Kind != rolechange.KindUpdated {
+		t.Errorf("got kind %s, want updated", c.Kind)
+	}
+
+	if c.PreviousTitle == nil || *c.PreviousTitle != "Viewer" {
+		t.Errorf("got previous title %v, want Viewer", c.PreviousTitle)
+	}
+
+	if c.PreviousStage == nil || *c.PreviousStage != rolechange.PreviousStageGA {
+		t.Errorf("got previous stage %v, want GA", c.PreviousStage)
+	}
+	assertStrings(t, "permissions added", c.PermissionsAdded, []string{"b.r.get"})
+	assertStrings(t, "permissions removed", c.PermissionsRemoved, []string{"a.r.list"})
+
+	// only the description changed, which is revised but not a change
+	described := testRole("roles/viewer", "Reader", "3", "a.r.get", "b.r.get")
+	described.Stage = adminpb.Role_DEPRECATED
+	described.Description = "reads"
+	source.SetRoles(described)
+	runSync(t, client, source)
+
+	if revs := revisions(t, client, "roles/viewer"); len(revs) != 3 {
+		t.Errorf("got %d revisions, want 3", len(revs))
+	}
+
+	if sets := changeSets(t, client); len(sets) != 2 {
+		t.Errorf("got %d change sets, want 2", len(sets))
+	}
+}
+
+func TestUpdateRolesDeletesAndUndeletesRoles(t *testing.T) {
+	client := newTestClient(t)
+	viewer := testRole("roles/viewer", "Viewer", "1", "a.r.get", "a.r.list")
+	source := adapters.NewMemoryRoleSource(viewer)
+	runSync(t, client, source)
+
+	source.SetRoles(deletedRole(viewer, "2"))
+	runSync(t, client, source)
+
+	r := getRole(t, client, "roles/viewer")
+	if r.DeletedAt == nil {
+		t.Fatalf("roles/viewer is not deleted")
+	}
+	deletedAt := *r.DeletedAt
+
+	// the tombstone keeps its permissions and their grants
+	assertStrings(t, "permissions", permissionNames(r.Edges.Permissions), []string{"a.r.get", "a.r.list"})
+	open, _ := grants(t, client, "roles/viewer")
+	assertStrings(t, "open grants", open, []string{"a.r.get", "a.r.list"})
+
+	// syncing the deleted role again leaves it deleted at the same time
+	runSync(t, client, source)
+	if r := getRole(t, client, "roles/viewer"); r.DeletedAt == nil || !r.DeletedAt.Equal(deletedAt) {
+		t.Errorf("got deleted at %v, want %v", r.DeletedAt, deletedAt)
+	}
+
+	source.SetRoles(testRole("roles/viewer", "Viewer", "3", "a.r.get"))
+	runSync(t, client, source)
+
+	r = getRole(t, client, "roles/viewer")
+	if r.DeletedAt != nil {
+		t.Errorf("roles/viewer is still deleted")
+	}
+	assertStrings(t, "permissions", permissionNames(r.Edges.Permissions), []string{"a.r.get"})
+
+	revs := revisions(t, client, "roles/viewer")
+	if len(revs) != 3 || revs[0].Deleted || !revs[1].Deleted || revs[2].Deleted {
+		t.Errorf("unexpected revisions: %v", revs)
+	}
+
+	var kinds []string
+	for _, set := range changeSets(t, client) {
+		for _, c := range set {
+			kinds = append(kinds, c.Kind.String())
+		}
+	}
+	assertStrings(t, "change kinds", kinds, []string{"added", "deleted", "undeleted"})
+}
+
+func TestUpdateRolesSkipsUnchangedRoles(t *testing.T) {
+	client := newTestClient(t)
+	source := adapters.NewMemoryRoleSource(testRole("roles/viewer", "Viewer", "1", "a.r.get"))
+	runSync(t, client, source)
+
+	// a role with the etag it was synced with is not updated, even if
+	// its other attributes differ
+	source.SetRoles(testRole("roles/viewer", "Renamed", "1", "a.r.get", "a.r.list"))
+	runSync(t, client, source)
+
+	r := getRole(t, client, "roles/viewer")
+	if r.Title != "Viewer" {
+		t.Errorf("got title %q, want the unchanged Viewer", r.Title)
+	}
+	assertStrings(t, "permissions", permissionNames(r.Edges.Permissions), []string{"a.r.get"})
+
+	if revs := revisions(t, client, "roles/viewer"); len(revs) != 1 {
+		t.Errorf("got %d revisions, want 1", len(revs))
+	}
+
+	if sets := changeSets(t, client); len(sets) != 1 {
+		t.Errorf("got %d change sets, want 1", len(sets))
+	}
+}
+
+func TestUpdateRolesSyncsPermissionsOfListedRoles(t *testing.T) {
+	client := newTestClient(t)
+
+	// roles listed without their permissions, e.g. by gcloud iam roles list,
+	// are updated once their permissions are included with the same etag
+	source := adapters.NewMemoryRoleSource(testRole("roles/viewer", "Viewer", "1"))
+	runSync(t, client, source)
+
+	source.SetRoles(testRole("roles/viewer", "Viewer", "1", "a.r.get"))
+	runSync(t, client, source)
+
+	assertStrings(t, "permissions", rolePermissions(t, client, "roles/viewer"), []string{"a.r.get"})
+}
+
+func TestUpdateRolesKeepsPermissionsOfListedRoles(t *testing.T) {
+	client := newTestClient(t)
+	dir := t.TempDir()
+
+	described := filepath.Join(dir, "described.yaml")
+	writeFile(t, described, `name: roles/viewer
+title: Viewer
+stage: GA
+etag: BwWKmjvelug=
+includedPermissions:
+- a.r.get
+- a.r.list
+---
+name: roles/none
+title: None
+stage: GA
+etag: BwWKmjvelug=
+includedPermissions: []
+`)
+	runSync(t, client, adapters.NewFileRoleSource(described))
+
+	// gcloud iam roles list exports the roles without their permissions
+	listed := filepath.Join(dir, "listed.json")
+	writeFile(t, listed, `[
+  {"name": "roles/viewer", "title": "Reader", "stage": "BETA", "etag": "BwXKmjvelug="},
+  {"name": "roles/none", "title": "None", "stage": "GA", "etag": "BwWKmjvelug="}
+]`)
+	runSync(t, client, adapters.NewFileRoleSource(listed))
+
+	r := getRole(t, client, "roles/viewer")
+	if r.Title != "Reader" || r.Stage != role.StageBETA {
+		t.Errorf("got title %q and stage %s, want Reader and BETA", r.Title, r.Stage)
+	}
+	assertStrings(t, "permissions", permissionNames(r.Edges.Permissions), []string{"a.r.get", "a.r.list"})
+
+	open, closed := grants(t, client, "roles/viewer")
+	assertStrings(t, "open grants", open, []string{"a.r.get", "a.r.list"})
+	assertStrings(t, "closed grants", closed, nil)
+
+	revs := revisions(t, client, "roles/viewer")
+	if len(revs) != 2 {
+		t.Fatalf("got %d revisions, want 2", len(revs))
+	}
+	assertStrings(t, "revision permissions", revs[1].Permissions, []string{"a.r.get", "a.r.list"})
+
+	sets := changeSets(t, client)
+	if len(sets) != 2 || len(sets[1]) != 1 {
+		t.Fatalf("got change sets %v, want a second one with 1 change", sets)
+	}
+
+	if c := sets[1][0]; len(c.PermissionsAdded) > 0 || len(c.PermissionsRemoved) > 0 {
+		t.Errorf("got permissions added %q and removed %q, want none", c.PermissionsAdded, c.PermissionsRemoved)
+	}
+}
+
+func writeFile(t *testing.T, path, data string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+func TestUpdateRolesSyncsCustomRoles(t *testing.T) {
+	client := newTestClient(t)
+	source := adapters.NewMemoryRoleSource(
+		testRole("roles/viewer", "Viewer", "1", "a.r.get"),
+		testRole("organizations/1/roles/custom", "Custom", "1", "a.r.get", "a.r.set"),
+		// custom roles may be untitled
+		testRole("projects/p/roles/untitled", "", "1", "a.r.list"),
+		testRole("projects/other/roles/ignored", "Ignored", "1", "a.r.list"),
+	)
+
+	runSync(t, client, source, "organizations/1", "projects/p")
+
+	names, err := client.Role.Query().Order(ent.Asc(role.FieldName)).Select(role.FieldName).Strings(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list roles: %v", err)
+	}
+	assertStrings(t, "roles", names, []string{"organizations/1/roles/custom", "projects/p/roles/untitled", "roles/viewer"})
+
+	custom := getRole(t, client, "organizations/1/roles/custom")
+	if custom.Scope != role.ScopeOrganization || custom.Parent != "organizations/1" {
+		t.Errorf("got scope %s and parent %q, want organization and organizations/1", custom.Scope, custom.Parent)
+	}
+}
+
+func TestUpdateRolesKeepsRolesWhenSourceFails(t *testing.T) {
+	client := newTestClient(t)
+	source := adapters.NewMemoryRoleSource(testRole("roles/viewer", "Viewer", "1", "a.r.get"))
+	runSync(t, client, source)
+
+	errUnavailable := errors.New("unavailable")
+	source.SetError(errUnavailable)
+	source.SetRoles(testRole("roles/viewer", "Renamed", "2", "a.r.list"))
+
+	err := NewUpdateRolesHandler(client, source).Handle(context.Background(), UpdateRoles{})
+	if !errors.Is(err, errUnavailable) {
+		t.Fatalf("got error %v, want %v", err, errUnavailable)
+	}
+
+	if r := getRole(t, client, "roles/viewer"); r.Title != "Viewer" {
+		t.Errorf("got title %q, want the unchanged Viewer", r.Title)
+	}
+}
+
+func TestParentScope(t *testing.T) {
+	tests := []struct {
+		parent  string
+		scope   role.Scope
+		invalid bool
+	}{
+		{parent: "", scope: role.ScopePredefined},
+		{parent: "organizations/123", scope: role.ScopeOrganization},
+		{parent: "projects/p", scope: role.ScopeProject},
+		{parent: "projects/", invalid: true},
+		{parent: "folders/1", invalid: true},
+		{parent: "organizations/1/roles", invalid: true},
+	}
+
+	for _, tt := range tests {
+		scope, err := ParentScope(tt.parent)
+		if tt.invalid {
+			if err == nil {
+				t.Errorf("ParentScope(%q) = %s, want an error", tt.parent, scope)
+			}
+			continue
+		}
+
+		if err != nil || scope != tt.scope {
+			t.Errorf("ParentScope(%q) = %s, %v, want %s", tt.parent, scope, err, tt.scope)
+		}
+	}
+}
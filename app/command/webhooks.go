@@ -0,0 +1,229 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/app/glob"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/rolechange"
+	"github.com/rosstimothy/iam/ent/webhook"
+	"github.com/rosstimothy/iam/ent/webhookdelivery"
+)
+
+// maxWebhookField is the length of the url and secret columns of webhooks.
+const maxWebhookField = 255
+
+type CreateWebhook struct {
+	// URL receives the deliveries as POST requests.
+	URL string
+	// Secret is the key of the HMAC signature of each delivery. It is
+	// stored in plaintext to sign the deliveries.
+	Secret string
+	// RolePatterns optionally limits the deliveries to the changes of the
+	// roles matching any of the glob patterns, e.g. roles/storage.*.
+	RolePatterns []string
+	// PermissionPatterns optionally limits the deliveries to the changes
+	// which add or remove permissions matching any of the glob patterns.
+	PermissionPatterns []string
+	// Kinds optionally limits the deliveries to the changes of the given
+	// kinds, i.e. added, deleted, undeleted or updated.
+	Kinds []string
+}
+
+type CreateWebhookHandler struct {
+	client *ent.Client
+}
+
+func NewCreateWebhookHandler(client *ent.Client) *CreateWebhookHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &CreateWebhookHandler{client: client}
+}
+
+// Handle creates the webhook and returns its id.
+func (h *CreateWebhookHandler) Handle(ctx context.Context, cmd CreateWebhook) (_ int, err error) {
+	fmt.Printf("creating webhook %s\n", cmd.URL)
+	defer func() {
+		if err != nil {
+			fmt.Printf("failed to create webhook %s\n", cmd.URL)
+			return
+		}
+
+		fmt.Printf("succesfully created webhook %s\n", cmd.URL)
+	}()
+
+	u, err := url.Parse(cmd.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return 0, apperr.InvalidArgument("invalid url %q: must be an absolute http or https url", cmd.URL)
+	}
+
+	if len(cmd.URL) > maxWebhookField {
+		return 0, apperr.InvalidArgument("invalid url: must be at most %d characters", maxWebhookField)
+	}
+
+	if cmd.Secret == "" {
+		return 0, apperr.InvalidArgument("no secret provided")
+	}
+
+	if len(cmd.Secret) > maxWebhookField {
+		return 0, apperr.InvalidArgument("invalid secret: must be at most %d characters", maxWebhookField)
+	}
+
+	for _, patterns := range [][]string{cmd.RolePatterns, cmd.PermissionPatterns} {
+		for _, p := range patterns {
+			if p == "" {
+				return 0, apperr.InvalidArgument("invalid empty pattern")
+			}
+		}
+	}
+
+	for _, kind := range cmd.Kinds {
+		if err := rolechange.KindValidator(rolechange.Kind(kind)); err != nil {
+			return 0, apperr.InvalidArgument("invalid kind %q", kind)
+		}
+	}
+
+	w, err := h.client.Webhook.Create().
+		SetURL(cmd.URL).
+		SetSecret(cmd.Secret).
+		SetRolePatterns(cmd.RolePatterns).
+		SetPermissionPatterns(cmd.PermissionPatterns).
+		SetKinds(cmd.Kinds).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return w.ID, nil
+}
+
+type DeleteWebhook struct {
+	ID int
+}
+
+type DeleteWebhookHandler struct {
+	client *ent.Client
+}
+
+func NewDeleteWebhookHandler(client *ent.Client) *DeleteWebhookHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &DeleteWebhookHandler{client: client}
+}
+
+// Handle deletes the webhook and its delivery log.
+func (h *DeleteWebhookHandler) Handle(ctx context.Context, cmd DeleteWebhook) (err error) {
+	fmt.Printf("deleting webhook %d\n", cmd.ID)
+	defer func() {
+		if err != nil {
+			fmt.Printf("failed to delete webhook %d\n", cmd.ID)
+			return
+		}
+
+		fmt.Printf("succesfully deleted webhook %d\n", cmd.ID)
+	}()
+
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.WebhookDelivery.Delete().
+		Where(webhookdelivery.HasWebhookWith(webhook.ID(cmd.ID))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Webhook.DeleteOneID(cmd.ID).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("webhook %d not found", cmd.ID)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// enqueueDeliveries schedules the delivery of changeSet to every webhook
+// subscribed to any of its changes.
+func enqueueDeliveries(ctx context.Context, tx *ent.Tx, changeSet *ent.ChangeSet, changes []*ent.RoleChange, now time.Time) error {
+	webhooks, err := tx.Webhook.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var deliveries []*ent.WebhookDeliveryCreate
+	for _, w := range webhooks {
+		for _, c := range changes {
+			if subscribed(w, c) {
+				deliveries = append(deliveries, tx.WebhookDelivery.Create().
+					SetWebhook(w).
+					SetChangeSet(changeSet).
+					SetNextAttemptAt(now).
+					SetCreatedAt(now))
+				break
+			}
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if _, err := tx.WebhookDelivery.CreateBulk(deliveries...).Save(ctx); err != nil {
+		return err
+	}
+
+	fmt.Printf("scheduled %d webhook deliveries\n", len(deliveries))
+
+	return nil
+}
+
+// subscribed reports whether the webhook w is subscribed to the change c.
+func subscribed(w *ent.Webhook, c *ent.RoleChange) bool {
+	if len(w.Kinds) > 0 && !matchesAny(w.Kinds, c.Kind.String(), false) {
+		return false
+	}
+
+	if len(w.RolePatterns) > 0 && !matchesAny(w.RolePatterns, c.Role, true) {
+		return false
+	}
+
+	if len(w.PermissionPatterns) == 0 {
+		return true
+	}
+
+	for _, perms := range [][]string{c.PermissionsAdded, c.PermissionsRemoved} {
+		for _, p := range perms {
+			if matchesAny(w.PermissionPatterns, p, true) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesAny reports whether s is one of values or, if patterns is set,
+// matches any of them as a glob pattern where * matches any number of
+// characters and ? matches a single character.
+func matchesAny(values []string, s string, patterns bool) bool {
+	for _, v := range values {
+		if v == s || patterns && glob.Regexp(v).MatchString(s) {
+			return true
+		}
+	}
+
+	return false
+}
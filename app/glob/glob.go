@@ -0,0 +1,27 @@
+// Package glob matches names against glob patterns, where * matches any
+// number of characters and ? matches a single character.
+package glob
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Regexp returns a regexp which matches the names matched by pattern.
+func Regexp(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, c := range pattern {
+		switch c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	return regexp.MustCompile(b.String())
+}
@@ -0,0 +1,150 @@
+package query
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolerevision"
+)
+
+// grantedAt matches the grants which were valid at asOf.
+func grantedAt(asOf time.Time) predicate.PermissionGrant {
+	return permissiongrant.And(
+		permissiongrant.ValidFromLTE(asOf),
+		permissiongrant.Or(permissiongrant.ValidToIsNil(), permissiongrant.ValidToGT(asOf)),
+	)
+}
+
+// grantedAnyAt matches the roles which granted any of the named
+// permissions at asOf.
+func grantedAnyAt(asOf time.Time, names ...string) predicate.Role {
+	return role.HasGrantsWith(
+		grantedAt(asOf),
+		permissiongrant.HasPermissionWith(permission.NameIn(names...)),
+	)
+}
+
+// grantedAllAt matches the roles which granted every one of the named
+// permissions at asOf.
+func grantedAllAt(asOf time.Time, names ...string) predicate.Role {
+	unique := map[string]bool{}
+	args := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		if !unique[name] {
+			unique[name] = true
+			args = append(args, name)
+		}
+	}
+
+	return predicate.Role(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		grants := builder.Table(permissiongrant.Table)
+		perms := builder.Table(permission.Table)
+		matches := builder.Select(grants.C(permissiongrant.RoleColumn)).
+			From(grants).
+			Join(perms).
+			On(grants.C(permissiongrant.PermissionColumn), perms.C(permission.FieldID)).
+			Where(sql.And(
+				sql.In(perms.C(permission.FieldName), args...),
+				sql.LTE(grants.C(permissiongrant.FieldValidFrom), asOf),
+				sql.Or(
+					sql.IsNull(grants.C(permissiongrant.FieldValidTo)),
+					sql.GT(grants.C(permissiongrant.FieldValidTo), asOf),
+				),
+			)).
+			GroupBy(grants.C(permissiongrant.RoleColumn)).
+			Having(sql.EQ(sql.Count(sql.Distinct(grants.C(permissiongrant.PermissionColumn))), len(args)))
+		s.Where(sql.In(s.C(role.FieldID), matches))
+	})
+}
+
+// checkGrantsAt returns an invalid argument error if asOf is before the
+// first recorded grant. Grants are only recorded from the first sync after
+// the db was upgraded to record them, so which roles granted a permission
+// before then is unknown.
+func checkGrantsAt(ctx context.Context, client *ent.Client, asOf time.Time) error {
+	first, err := client.PermissionGrant.Query().
+		Order(ent.Asc(permissiongrant.FieldValidFrom)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if asOf.Before(first.ValidFrom) {
+		return apperr.InvalidArgument("as_of %s is before the first recorded grant at %s", asOf.Format(time.RFC3339), first.ValidFrom.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// latestRevisionAt matches the latest revision of each role recorded at
+// or before asOf.
+func latestRevisionAt(asOf time.Time) predicate.RoleRevision {
+	return func(s *sql.Selector) {
+		t := sql.Table(rolerevision.Table).As("latest")
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(rolerevision.FieldRevision)).WriteOp(sql.OpEQ).Nested(func(b *sql.Builder) {
+				b.Join(sql.Select(sql.Max(t.C(rolerevision.FieldRevision))).
+					From(t).
+					Where(sql.And(
+						sql.ColumnsEQ(t.C(rolerevision.RoleColumn), s.C(rolerevision.RoleColumn)),
+						sql.LTE(t.C(rolerevision.FieldCreatedAt), asOf),
+					)))
+			})
+		}))
+	}
+}
+
+// revisionsAt returns the latest revision recorded at or before asOf of each
+// role matching preds, keyed by the id of the role, with the role loaded.
+// Roles which did not exist yet at asOf have no revision.
+func revisionsAt(ctx context.Context, client *ent.Client, asOf time.Time, preds ...predicate.Role) (map[int]*ent.RoleRevision, error) {
+	revisions, err := client.RoleRevision.Query().
+		Where(latestRevisionAt(asOf), rolerevision.HasRoleWith(preds...)).
+		WithRole().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[int]*ent.RoleRevision, len(revisions))
+	for _, rev := range revisions {
+		latest[rev.Edges.Role.ID] = rev
+	}
+
+	return latest, nil
+}
+
+// revisionRole returns the role as recorded by rev, deleted at the time
+// of the revision if the role was deleted.
+func revisionRole(rev *ent.RoleRevision) Role {
+	r := Role{
+		Name:        rev.Edges.Role.Name,
+		Title:       rev.Title,
+		Description: rev.Description,
+		Permissions: rev.Permissions,
+		Stage:       rev.Stage.String(),
+		Etag:        hex.EncodeToString(rev.Etag),
+		Scope:       rev.Edges.Role.Scope.String(),
+		Parent:      rev.Edges.Role.Parent,
+	}
+
+	if rev.Deleted {
+		deletedAt := rev.CreatedAt
+		r.DeletedAt = &deletedAt
+	}
+
+	return r
+}
@@ -0,0 +1,154 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/enttest"
+	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolerevision"
+)
+
+// asOfClient returns a client of an in memory db in which roles/a granted
+// p.1 and p.2 from synced until revised, and only p.1 afterwards, while
+// roles/b granted p.2 throughout.
+func asOfClient(t *testing.T, synced, revised time.Time) *ent.Client {
+	t.Helper()
+
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", name))
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	p1 := client.Permission.Create().SetName("p.1").SaveX(ctx)
+	p2 := client.Permission.Create().SetName("p.2").SaveX(ctx)
+
+	newRole := func(name, title string) *ent.Role {
+		return client.Role.Create().
+			SetName(name).
+			SetTitle(title).
+			SetDescription("").
+			SetStage(role.StageGA).
+			SetEtag([]byte("1")).
+			SaveX(ctx)
+	}
+
+	revise := func(r *ent.Role, revision int, title string, at time.Time, permissions ...string) {
+		client.RoleRevision.Create().
+			SetRole(r).
+			SetRevision(revision).
+			SetTitle(title).
+			SetDescription("").
+			SetStage(rolerevision.StageGA).
+			SetEtag([]byte("1")).
+			SetPermissions(permissions).
+			SetCreatedAt(at).
+			SaveX(ctx)
+	}
+
+	a := newRole("roles/a", "A2")
+	client.Role.UpdateOne(a).AddPermissions(p1).ExecX(ctx)
+	revise(a, 1, "A1", synced, "p.1", "p.2")
+	revise(a, 2, "A2", revised, "p.1")
+	client.PermissionGrant.Create().SetRole(a).SetPermission(p1).SetValidFrom(synced).SaveX(ctx)
+	client.PermissionGrant.Create().SetRole(a).SetPermission(p2).SetValidFrom(synced).SetValidTo(revised).SaveX(ctx)
+
+	b := newRole("roles/b", "B")
+	client.Role.UpdateOne(b).AddPermissions(p2).ExecX(ctx)
+	revise(b, 1, "B", synced, "p.2")
+	client.PermissionGrant.Create().SetRole(b).SetPermission(p2).SetValidFrom(synced).SaveX(ctx)
+
+	return client
+}
+
+func roleTitles(roles []Role) []string {
+	titles := make([]string, len(roles))
+	for i, r := range roles {
+		titles[i] = r.Title
+	}
+
+	return titles
+}
+
+func TestRolesWithPermissionsAsOf(t *testing.T) {
+	synced := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	revised := synced.Add(24 * time.Hour)
+	client := asOfClient(t, synced, revised)
+	handler := NewRolesWithPermissionsHandler(client)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		asOf  time.Time
+		match Match
+		want  []string
+	}{
+		{name: "all before revision", asOf: synced.Add(time.Hour), want: []string{"A1"}},
+		{name: "any before revision", asOf: synced.Add(time.Hour), match: MatchAny, want: []string{"A1", "B"}},
+		{name: "any after revision", asOf: revised.Add(time.Hour), match: MatchAny, want: []string{"A2", "B"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asOf := tt.asOf
+			roles, err := handler.Handle(ctx, RolesWithPermissions{Permissions: []string{"p.1", "p.2"}, Match: tt.match, AsOf: &asOf})
+			if err != nil {
+				t.Fatalf("failed to find roles: %v", err)
+			}
+
+			if got := roleTitles(roles); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got roles %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// a stopped granting p.2 when it was revised
+	asOf := revised.Add(time.Hour)
+	_, err := handler.Handle(ctx, RolesWithPermissions{Permissions: []string{"p.1", "p.2"}, AsOf: &asOf})
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) || appErr.Kind != apperr.KindNotFound {
+		t.Errorf("got error %v, want not found", err)
+	}
+}
+
+func TestPermissionByNameAsOf(t *testing.T) {
+	synced := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	revised := synced.Add(24 * time.Hour)
+	client := asOfClient(t, synced, revised)
+	handler := NewPermissionByNameHandler(client)
+	ctx := context.Background()
+
+	for asOf, want := range map[time.Time][]string{
+		synced:                  {"roles/a", "roles/b"},
+		revised.Add(-time.Hour): {"roles/a", "roles/b"},
+		revised:                 {"roles/b"},
+	} {
+		asOf := asOf
+		p, err := handler.Handle(ctx, PermissionByName{Permission: "p.2", AsOf: &asOf})
+		if err != nil {
+			t.Fatalf("failed to get permission at %s: %v", asOf, err)
+		}
+
+		if !reflect.DeepEqual(p.Roles, want) {
+			t.Errorf("got roles %q at %s, want %q", p.Roles, asOf, want)
+		}
+	}
+
+	// which roles granted the permission before the grants were recorded
+	// is unknown
+	asOf := synced.Add(-time.Hour)
+	_, err := handler.Handle(ctx, PermissionByName{Permission: "p.2", AsOf: &asOf})
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) || appErr.Kind != apperr.KindInvalidArgument {
+		t.Errorf("got error %v, want invalid argument", err)
+	}
+}
@@ -0,0 +1,15 @@
+package query
+
+import (
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/role"
+)
+
+// notDeleted hides the roles which are deleted upstream unless includeDeleted is set.
+func notDeleted(includeDeleted bool) []predicate.Role {
+	if includeDeleted {
+		return nil
+	}
+
+	return []predicate.Role{role.DeletedAtIsNil()}
+}
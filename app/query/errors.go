@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent"
+)
+
+// errCatalogUnavailable is returned when nothing can be found
+// because no roles have been synced yet.
+var errCatalogUnavailable = &apperr.Error{Kind: apperr.KindUnavailable, Message: "the role catalog has not been synced yet"}
+
+// wrapError classifies errors returned by ent and the db driver
+// which were not already classified by the queries.
+func wrapError(err error) error {
+	var appErr *apperr.Error
+	switch {
+	case err == nil, errors.As(err, &appErr):
+		return err
+	case ent.IsNotFound(err):
+		return &apperr.Error{Kind: apperr.KindNotFound, Message: "not found", Err: err}
+	case ent.IsNotSingular(err), ent.IsConstraintError(err):
+		return &apperr.Error{Kind: apperr.KindConflict, Message: "conflicting data", Err: err}
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled),
+		errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone):
+		return &apperr.Error{Kind: apperr.KindUnavailable, Message: "the db is unavailable", Err: err}
+	}
+
+	return err
+}
+
+// notFoundError returns errCatalogUnavailable if no roles have been
+// synced yet, otherwise a not found error with the given message.
+func notFoundError(ctx context.Context, client *ent.Client, format string, args ...interface{}) error {
+	exists, err := client.Role.Query().Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errCatalogUnavailable
+	}
+
+	return apperr.NotFound(format, args...)
+}
@@ -0,0 +1,189 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/role"
+)
+
+type LeastPrivilegeRoles struct {
+	// Permissions are the names of the permissions, or glob patterns
+	// matching them such as storage.objects.*.
+	Permissions []string
+	// IncludeExtra populates the extra permissions granted by each role.
+	IncludeExtra bool
+	// Stages optionally limits the roles to those in the given stages.
+	Stages []string
+	// ExcludeStages leaves out the roles in the given stages,
+	// DEPRECATED and ALPHA if nil and no Stages are given.
+	ExcludeStages []string
+	// IncludeDeleted includes the roles which are deleted upstream.
+	IncludeDeleted bool
+	// Scope optionally limits the roles to those of the given scope.
+	Scope string
+	// Parent optionally limits the roles to those defined by the given parent.
+	Parent string
+	// Service optionally limits the roles to those granting permissions of the service.
+	Service string
+}
+
+// RankedRole is a role which grants all requested permissions
+// along with the permissions it grants in excess of them.
+type RankedRole struct {
+	Name            string     `json:"name"`
+	Title           string     `json:"title"`
+	Stage           string     `json:"stage"`
+	Scope           string     `json:"scope"`
+	PermissionCount int        `json:"permission_count"`
+	ExtraCount      int        `json:"extra_count"`
+	Extra           []string   `json:"extra,omitempty"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+}
+
+type RankedRoles struct {
+	Roles []RankedRole `json:"roles"`
+	// Expansions are the permissions matched by the requested patterns.
+	Expansions []PermissionExpansion `json:"expansions,omitempty"`
+}
+
+type LeastPrivilegeRolesHandler struct {
+	client *ent.Client
+}
+
+func NewLeastPrivilegeRolesHandler(client *ent.Client) *LeastPrivilegeRolesHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &LeastPrivilegeRolesHandler{client: client}
+}
+
+// Handle returns the roles which grant all requested permissions ordered by
+// the number of extra permissions they grant, fewest first.
+func (l *LeastPrivilegeRolesHandler) Handle(ctx context.Context, cmd LeastPrivilegeRoles) (_ *RankedRoles, err error) {
+	fmt.Printf("ranking roles with permissions %s\n", cmd.Permissions)
+
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to rank roles with permissions %s\n", cmd.Permissions)
+			return
+		}
+
+		fmt.Printf("succesfully ranked roles with permissions %s\n", cmd.Permissions)
+	}()
+
+	if len(cmd.Permissions) == 0 {
+		return nil, apperr.InvalidArgument("no permissions provided")
+	}
+
+	permissions, expansions, err := expandPermissions(ctx, l.client, cmd.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	required := make(map[string]bool, len(permissions))
+	for _, p := range permissions {
+		required[p] = true
+	}
+
+	preds, err := recommendedStages(cmd.Stages, cmd.ExcludeStages)
+	if err != nil {
+		return nil, err
+	}
+	preds = append(preds, notDeleted(cmd.IncludeDeleted)...)
+	preds = append(preds, hasAllPermissions(permissions...))
+	scopePreds, err := scopePredicates(cmd.Scope, cmd.Parent)
+	if err != nil {
+		return nil, err
+	}
+	preds = append(preds, scopePreds...)
+	preds = append(preds, servicePredicates(cmd.Service)...)
+
+	query := l.client.Role.Query().Where(preds...)
+	if cmd.IncludeExtra {
+		query = query.WithPermissions()
+	}
+
+	roles, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := permissionCounts(ctx, l.client, preds...)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]RankedRole, len(roles))
+	for i, r := range roles {
+		ranked[i] = RankedRole{
+			Name:            r.Name,
+			Title:           r.Title,
+			Stage:           r.Stage.String(),
+			Scope:           r.Scope.String(),
+			DeletedAt:       r.DeletedAt,
+			PermissionCount: counts[r.ID],
+			ExtraCount:      counts[r.ID] - len(required),
+		}
+
+		if !cmd.IncludeExtra {
+			continue
+		}
+
+		ranked[i].Extra = make([]string, 0, ranked[i].ExtraCount)
+		for _, p := range r.Edges.Permissions {
+			if !required[p.Name] {
+				ranked[i].Extra = append(ranked[i].Extra, p.Name)
+			}
+		}
+		sort.Strings(ranked[i].Extra)
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].ExtraCount != ranked[j].ExtraCount {
+			return ranked[i].ExtraCount < ranked[j].ExtraCount
+		}
+
+		return ranked[i].Name < ranked[j].Name
+	})
+
+	return &RankedRoles{Roles: ranked, Expansions: expansions}, nil
+}
+
+// permissionCounts returns the number of permissions granted by each
+// role matching preds keyed by role id.
+func permissionCounts(ctx context.Context, client *ent.Client, preds ...predicate.Role) (map[int]int, error) {
+	var v []struct {
+		ID    int `json:"id"`
+		Count int `json:"count"`
+	}
+
+	err := client.Role.Query().
+		Where(preds...).
+		GroupBy(role.FieldID).
+		Aggregate(func(s *sql.Selector) string {
+			edge := sql.Table(role.PermissionsTable)
+			s.Join(edge).On(s.C(role.FieldID), edge.C(role.PermissionsPrimaryKey[0]))
+			return sql.As(sql.Count(edge.C(role.PermissionsPrimaryKey[1])), "count")
+		}).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int, len(v))
+	for _, c := range v {
+		counts[c.ID] = c.Count
+	}
+
+	return counts, nil
+}
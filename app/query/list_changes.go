@@ -0,0 +1,162 @@
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/changeset"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/rolechange"
+)
+
+type ListChanges struct {
+	// Since optionally limits the changes to those made by syncs after it.
+	Since *time.Time
+	// After optionally limits the changes to those with a larger id.
+	After int
+	// PageSize is the maximum number of changes returned, 100 if not set.
+	PageSize int
+	// PageToken is the NextPageToken of the previous page.
+	PageToken string
+}
+
+type ChangePage struct {
+	Changes []Change `json:"changes"`
+	// NextPageToken retrieves the next page, empty on the last page.
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+type ListChangesHandler struct {
+	client *ent.Client
+}
+
+func NewListChangesHandler(client *ent.Client) *ListChangesHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &ListChangesHandler{client: client}
+}
+
+// Handle returns a page of the changes made by the syncs, oldest first.
+func (l *ListChangesHandler) Handle(ctx context.Context, cmd ListChanges) (_ *ChangePage, err error) {
+	fmt.Println("listing changes")
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Println("failed to list changes")
+			return
+		}
+
+		fmt.Println("succesfully listed changes")
+	}()
+
+	pageSize, err := validPageSize(cmd.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var preds []predicate.RoleChange
+	if cmd.After > 0 {
+		preds = append(preds, rolechange.IDGT(cmd.After))
+	}
+
+	if cmd.Since != nil {
+		preds = append(preds, rolechange.HasChangeSetWith(changeset.CreatedAtGT(cmd.Since.UTC())))
+	}
+
+	if cmd.PageToken != "" {
+		last, err := base64.RawURLEncoding.DecodeString(cmd.PageToken)
+		if err != nil {
+			return nil, apperr.InvalidArgument("invalid page token")
+		}
+
+		id, err := strconv.Atoi(string(last))
+		if err != nil {
+			return nil, apperr.InvalidArgument("invalid page token")
+		}
+		preds = append(preds, rolechange.IDGT(id))
+	}
+
+	changes, err := l.client.RoleChange.Query().
+		Where(preds...).
+		WithChangeSet().
+		Order(ent.Asc(rolechange.FieldID)).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &ChangePage{Changes: []Change{}}
+	for i, c := range changes {
+		if i == pageSize {
+			page.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(changes[i-1].ID)))
+			break
+		}
+
+		page.Changes = append(page.Changes, roleChange(c))
+	}
+
+	return page, nil
+}
+
+type LastChangeID struct{}
+
+type LastChangeIDHandler struct {
+	client *ent.Client
+}
+
+func NewLastChangeIDHandler(client *ent.Client) *LastChangeIDHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &LastChangeIDHandler{client: client}
+}
+
+// Handle returns the id of the latest change, 0 if no changes were made yet.
+func (l *LastChangeIDHandler) Handle(ctx context.Context, _ LastChangeID) (_ int, err error) {
+	defer func() {
+		err = wrapError(err)
+	}()
+
+	last, err := l.client.RoleChange.Query().
+		Order(ent.Desc(rolechange.FieldID)).
+		FirstID(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+
+	return last, err
+}
+
+// roleChange returns c, which must have its change set loaded, as a Change.
+func roleChange(c *ent.RoleChange) Change {
+	change := Change{
+		ID:                 c.ID,
+		ChangeSet:          c.Edges.ChangeSet.ID,
+		SyncedAt:           c.Edges.ChangeSet.CreatedAt,
+		Role:               c.Role,
+		Kind:               c.Kind.String(),
+		Title:              c.Title,
+		Stage:              c.Stage.String(),
+		PermissionsAdded:   c.PermissionsAdded,
+		PermissionsRemoved: c.PermissionsRemoved,
+	}
+
+	if c.PreviousTitle != nil {
+		change.PreviousTitle = *c.PreviousTitle
+	}
+
+	if c.PreviousStage != nil {
+		change.PreviousStage = c.PreviousStage.String()
+	}
+
+	return change
+}
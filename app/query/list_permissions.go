@@ -0,0 +1,158 @@
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/service"
+)
+
+type ListPermissions struct {
+	// Prefix optionally limits the permissions to those whose name starts with it.
+	Prefix string
+	// Service optionally limits the permissions to those of the named
+	// service, e.g. storage.
+	Service string
+	// PageSize is the maximum number of permissions returned, 100 if not set.
+	PageSize int
+	// PageToken is the NextPageToken of the previous page.
+	PageToken string
+}
+
+type PermissionPage struct {
+	Permissions []PermissionSummary `json:"permissions"`
+	// NextPageToken retrieves the next page, empty on the last page.
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+type ListPermissionsHandler struct {
+	client *ent.Client
+}
+
+func NewListPermissionsHandler(client *ent.Client) *ListPermissionsHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &ListPermissionsHandler{client: client}
+}
+
+// Handle returns a page of the permissions matching the filters of cmd ordered by name.
+func (l *ListPermissionsHandler) Handle(ctx context.Context, cmd ListPermissions) (_ *PermissionPage, err error) {
+	fmt.Printf("listing permissions of service %q with prefix %q\n", cmd.Service, cmd.Prefix)
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to list permissions of service %q with prefix %q\n", cmd.Service, cmd.Prefix)
+			return
+		}
+
+		fmt.Printf("succesfully listed permissions of service %q with prefix %q\n", cmd.Service, cmd.Prefix)
+	}()
+
+	pageSize, err := validPageSize(cmd.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var preds []predicate.Permission
+	if cmd.Prefix != "" {
+		preds = append(preds, permission.NameHasPrefix(cmd.Prefix))
+	}
+
+	if cmd.Service != "" {
+		if strings.Contains(cmd.Service, ".") {
+			return nil, apperr.InvalidArgument("invalid service %q", cmd.Service)
+		}
+		preds = append(preds, permission.HasServiceWith(service.NameEQ(cmd.Service)))
+	}
+
+	if cmd.PageToken != "" {
+		last, err := base64.RawURLEncoding.DecodeString(cmd.PageToken)
+		if err != nil {
+			return nil, apperr.InvalidArgument("invalid page token")
+		}
+		preds = append(preds, permission.NameGT(string(last)))
+	}
+
+	permissions, err := l.client.Permission.Query().
+		Where(preds...).
+		Order(ent.Asc(permission.FieldName)).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(permissions))
+	for i, p := range permissions {
+		ids[i] = p.ID
+	}
+
+	counts, err := roleCounts(ctx, l.client, permission.IDIn(ids...))
+	if err != nil {
+		return nil, err
+	}
+
+	page := &PermissionPage{Permissions: []PermissionSummary{}}
+	for i, p := range permissions {
+		if i == pageSize {
+			page.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(permissions[i-1].Name))
+			break
+		}
+
+		page.Permissions = append(page.Permissions, PermissionSummary{
+			Name:      p.Name,
+			RoleCount: counts[p.ID],
+		})
+	}
+
+	return page, nil
+}
+
+// roleCounts returns the number of roles, which are not deleted,
+// granting each permission matching preds keyed by permission id.
+func roleCounts(ctx context.Context, client *ent.Client, preds ...predicate.Permission) (map[int]int, error) {
+	var v []struct {
+		ID    int `json:"id"`
+		Count int `json:"count"`
+	}
+
+	err := client.Permission.Query().
+		Where(preds...).
+		GroupBy(permission.FieldID).
+		Aggregate(func(s *sql.Selector) string {
+			// only select the edge columns as the id of the group is not qualified
+			builder := sql.Dialect(s.Dialect())
+			edges := builder.Table(permission.RolesTable)
+			roles := builder.Table(role.Table)
+			edge := builder.Select(edges.C(permission.RolesPrimaryKey[0]), edges.C(permission.RolesPrimaryKey[1])).
+				From(edges).
+				Join(roles).
+				On(edges.C(permission.RolesPrimaryKey[0]), roles.C(role.FieldID)).
+				Where(sql.IsNull(roles.C(role.FieldDeletedAt))).
+				As("e")
+			s.Join(edge).On(s.C(permission.FieldID), edge.C(permission.RolesPrimaryKey[1]))
+			return sql.As(sql.Count(edge.C(permission.RolesPrimaryKey[0])), "count")
+		}).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int, len(v))
+	for _, c := range v {
+		counts[c.ID] = c.Count
+	}
+
+	return counts, nil
+}
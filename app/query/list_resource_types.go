@@ -0,0 +1,136 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/resourcetype"
+	"github.com/rosstimothy/iam/ent/service"
+)
+
+type ListResourceTypes struct {
+	Service string
+}
+
+type ListResourceTypesHandler struct {
+	client *ent.Client
+}
+
+func NewListResourceTypesHandler(client *ent.Client) *ListResourceTypesHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &ListResourceTypesHandler{client: client}
+}
+
+// Handle returns the resource types of a service and their verbs ordered by name.
+func (l *ListResourceTypesHandler) Handle(ctx context.Context, cmd ListResourceTypes) (_ []ResourceType, err error) {
+	fmt.Printf("looking for resource types of service %s\n", cmd.Service)
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to find resource types of service %s\n", cmd.Service)
+			return
+		}
+
+		fmt.Printf("succesfully found resource types of service %s\n", cmd.Service)
+	}()
+
+	s, err := l.client.Service.Query().
+		Where(service.Name(cmd.Service)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, notFoundError(ctx, l.client, "service %s not found", cmd.Service)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resourceTypes, err := s.QueryResourceTypes().
+		WithPermissions().
+		Order(ent.Asc(resourcetype.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ResourceType, len(resourceTypes))
+	for i, rt := range resourceTypes {
+		result[i] = newResourceType(s.Name, rt, false)
+	}
+
+	return result, nil
+}
+
+type ResourceTypeByName struct {
+	Service      string
+	ResourceType string
+}
+
+type ResourceTypeByNameHandler struct {
+	client *ent.Client
+}
+
+func NewResourceTypeByNameHandler(client *ent.Client) *ResourceTypeByNameHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &ResourceTypeByNameHandler{client: client}
+}
+
+// Handle returns a resource type of a service with its verbs and permissions.
+func (l *ResourceTypeByNameHandler) Handle(ctx context.Context, cmd ResourceTypeByName) (_ *ResourceType, err error) {
+	fmt.Printf("looking for resource type %s.%s\n", cmd.Service, cmd.ResourceType)
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to find resource type %s.%s\n", cmd.Service, cmd.ResourceType)
+			return
+		}
+
+		fmt.Printf("succesfully found resource type %s.%s\n", cmd.Service, cmd.ResourceType)
+	}()
+
+	rt, err := l.client.ResourceType.Query().
+		Where(
+			resourcetype.Name(cmd.ResourceType),
+			resourcetype.HasServiceWith(service.Name(cmd.Service)),
+		).
+		WithPermissions(func(q *ent.PermissionQuery) {
+			q.Order(ent.Asc(permission.FieldName))
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, notFoundError(ctx, l.client, "resource type %s.%s not found", cmd.Service, cmd.ResourceType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r := newResourceType(cmd.Service, rt, true)
+	return &r, nil
+}
+
+func newResourceType(serviceName string, rt *ent.ResourceType, withPermissions bool) ResourceType {
+	r := ResourceType{Service: serviceName, Name: rt.Name, Verbs: []string{}}
+
+	verbs := map[string]bool{}
+	for _, p := range rt.Edges.Permissions {
+		if !verbs[p.Verb] {
+			verbs[p.Verb] = true
+			r.Verbs = append(r.Verbs, p.Verb)
+		}
+
+		if withPermissions {
+			r.Permissions = append(r.Permissions, p.Name)
+		}
+	}
+	sort.Strings(r.Verbs)
+
+	return r
+}
@@ -0,0 +1,484 @@
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/role"
+)
+
+// RoleSort is the attribute roles are listed by.
+type RoleSort string
+
+const (
+	RoleSortName            RoleSort = "name"
+	RoleSortTitle           RoleSort = "title"
+	RoleSortStage           RoleSort = "stage"
+	RoleSortPermissionCount RoleSort = "permission_count"
+)
+
+type ListRoles struct {
+	// Sort orders the roles, RoleSortName if not set. Roles with
+	// the same value are ordered by name.
+	Sort RoleSort
+	// Descending reverses the order of Sort.
+	Descending bool
+	// Stages optionally limits the roles to those in the given stages.
+	Stages []string
+	// ExcludeStages optionally leaves out the roles in the given stages.
+	ExcludeStages []string
+	// IncludeDeleted includes the roles which are deleted upstream.
+	IncludeDeleted bool
+	// NamePrefix optionally limits the roles to those whose name starts with it.
+	NamePrefix string
+	// Title optionally limits the roles to those whose title contains it, ignoring case.
+	Title string
+	// Scope optionally limits the roles to those of the given scope.
+	Scope string
+	// Parent optionally limits the roles to those defined by the given parent.
+	Parent string
+	// Service optionally limits the roles to those granting permissions of the service.
+	Service string
+	// Permissions optionally limits the roles to those granting all or any of them.
+	// Glob patterns such as storage.objects.* are replaced by the permissions they match.
+	Permissions []string
+	// Match determines whether roles must grant all or any of the
+	// Permissions, MatchAll if not set.
+	Match Match
+	// PageSize is the maximum number of roles returned, 100 if not set.
+	PageSize int
+	// PageToken is the NextPageToken of the previous page.
+	PageToken string
+	// AsOf optionally lists the roles as they were at the given time
+	// instead of as they are now.
+	AsOf *time.Time
+}
+
+type RolePage struct {
+	Roles []RoleSummary `json:"roles"`
+	// Expansions are the permissions matched by the requested patterns.
+	Expansions []PermissionExpansion `json:"expansions,omitempty"`
+	// NextPageToken retrieves the next page, empty on the last page.
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+// rolesCursor is the position of the last role of a page
+// within the requested order.
+type rolesCursor struct {
+	Sort       RoleSort `json:"s"`
+	Descending bool     `json:"d,omitempty"`
+	Name       string   `json:"n"`
+	Title      string   `json:"t,omitempty"`
+	Value      int      `json:"v,omitempty"`
+}
+
+func (c rolesCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeRolesCursor(token string) (*rolesCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var c rolesCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+type ListRolesHandler struct {
+	client *ent.Client
+}
+
+func NewListRolesHandler(client *ent.Client) *ListRolesHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &ListRolesHandler{client: client}
+}
+
+// Handle returns a page of the roles matching the filters of cmd.
+func (l *ListRolesHandler) Handle(ctx context.Context, cmd ListRoles) (_ *RolePage, err error) {
+	fmt.Printf("listing roles by %s\n", cmd.Sort)
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to list roles by %s\n", cmd.Sort)
+			return
+		}
+
+		fmt.Printf("succesfully listed roles by %s\n", cmd.Sort)
+	}()
+
+	sort := cmd.Sort
+	switch sort {
+	case "":
+		sort = RoleSortName
+	case RoleSortName, RoleSortTitle, RoleSortStage, RoleSortPermissionCount:
+	default:
+		return nil, apperr.InvalidArgument("invalid sort %q", cmd.Sort)
+	}
+
+	pageSize, err := validPageSize(cmd.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var expansions []PermissionExpansion
+	if len(cmd.Permissions) > 0 {
+		cmd.Permissions, expansions, err = expandPermissions(ctx, l.client, cmd.Permissions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var cursor *rolesCursor
+	if cmd.PageToken != "" {
+		cursor, err = decodeRolesCursor(cmd.PageToken)
+		if err != nil || cursor.Sort != sort || cursor.Descending != cmd.Descending {
+			return nil, apperr.InvalidArgument("invalid page token")
+		}
+	}
+
+	if cmd.AsOf != nil {
+		return l.rolesAt(ctx, cmd, sort, pageSize, cursor, expansions)
+	}
+
+	preds, err := listRolesPredicates(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursor != nil {
+		preds = append(preds, afterCursor(cursor))
+	}
+
+	// the predicates never join rows, and postgres doesn't allow ordering
+	// a distinct selection by the permission count subquery
+	roles, err := l.client.Role.Query().
+		Where(preds...).
+		Unique(false).
+		Order(orderRoles(sort, cmd.Descending)).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(roles))
+	for i, r := range roles {
+		ids[i] = r.ID
+	}
+
+	counts, err := permissionCounts(ctx, l.client, role.IDIn(ids...))
+	if err != nil {
+		return nil, err
+	}
+
+	page := &RolePage{Roles: []RoleSummary{}, Expansions: expansions}
+	for i, r := range roles {
+		if i == pageSize {
+			last := roles[i-1]
+			page.NextPageToken = rolesCursor{
+				Sort:       sort,
+				Descending: cmd.Descending,
+				Name:       last.Name,
+				Title:      last.Title,
+				Value:      cursorValue(sort, last, counts),
+			}.encode()
+			break
+		}
+
+		page.Roles = append(page.Roles, RoleSummary{
+			Name:            r.Name,
+			Title:           r.Title,
+			Stage:           r.Stage.String(),
+			Scope:           r.Scope.String(),
+			DeletedAt:       r.DeletedAt,
+			PermissionCount: counts[r.ID],
+		})
+	}
+
+	return page, nil
+}
+
+func listRolesPredicates(cmd ListRoles) ([]predicate.Role, error) {
+	preds, err := stagePredicates(cmd.Stages, cmd.ExcludeStages)
+	if err != nil {
+		return nil, err
+	}
+	preds = append(preds, notDeleted(cmd.IncludeDeleted)...)
+
+	if cmd.NamePrefix != "" {
+		preds = append(preds, role.NameHasPrefix(cmd.NamePrefix))
+	}
+
+	if cmd.Title != "" {
+		preds = append(preds, role.TitleContainsFold(cmd.Title))
+	}
+
+	scopePreds, err := scopePredicates(cmd.Scope, cmd.Parent)
+	if err != nil {
+		return nil, err
+	}
+	preds = append(preds, scopePreds...)
+
+	preds = append(preds, servicePredicates(cmd.Service)...)
+
+	if len(cmd.Permissions) > 0 {
+		switch cmd.Match {
+		case MatchAll, "":
+			preds = append(preds, hasAllPermissions(cmd.Permissions...))
+		case MatchAny:
+			preds = append(preds, role.HasPermissionsWith(permission.NameIn(cmd.Permissions...)))
+		default:
+			return nil, apperr.InvalidArgument("invalid match %q", cmd.Match)
+		}
+	}
+
+	return preds, nil
+}
+
+func cursorValue(sort RoleSort, r *ent.Role, counts map[int]int) int {
+	switch sort {
+	case RoleSortStage:
+		return stageOrder[r.Stage]
+	case RoleSortPermissionCount:
+		return counts[r.ID]
+	}
+
+	return 0
+}
+
+// permissionCountQuery returns the subquery counting the
+// permissions of the role selected by s.
+func permissionCountQuery(s *sql.Selector) string {
+	builder := sql.Dialect(s.Dialect())
+	edge := builder.Table(role.PermissionsTable)
+	query, _ := builder.Select(sql.Count("*")).
+		From(edge).
+		Where(sql.ColumnsEQ(edge.C(role.PermissionsPrimaryKey[0]), s.C(role.FieldID))).
+		Query()
+
+	return "(" + query + ")"
+}
+
+// orderRoles orders roles by sort and then by name.
+func orderRoles(sort RoleSort, descending bool) ent.OrderFunc {
+	direction := ""
+	if descending {
+		direction = " DESC"
+	}
+
+	return func(s *sql.Selector) {
+		switch sort {
+		case RoleSortTitle:
+			s.OrderBy(s.C(role.FieldTitle) + direction)
+		case RoleSortStage:
+			b := &sql.Builder{}
+			b.SetDialect(s.Dialect())
+			orderedStage(s)(b)
+			s.OrderExpr(sql.Raw(b.String() + direction))
+		case RoleSortPermissionCount:
+			s.OrderExpr(sql.Raw(permissionCountQuery(s) + direction))
+		case RoleSortName:
+			s.OrderBy(s.C(role.FieldName) + direction)
+			return
+		}
+
+		s.OrderBy(s.C(role.FieldName))
+	}
+}
+
+// afterCursor matches the roles ordered after cursor.
+func afterCursor(cursor *rolesCursor) predicate.Role {
+	op := sql.OpGT
+	if cursor.Descending {
+		op = sql.OpLT
+	}
+
+	return func(s *sql.Selector) {
+		var key func(b *sql.Builder)
+		var value interface{}
+		switch cursor.Sort {
+		case RoleSortName:
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.Ident(s.C(role.FieldName)).WriteOp(op).Arg(cursor.Name)
+			}))
+			return
+		case RoleSortTitle:
+			key, value = func(b *sql.Builder) { b.Ident(s.C(role.FieldTitle)) }, cursor.Title
+		case RoleSortStage:
+			key, value = orderedStage(s), cursor.Value
+		case RoleSortPermissionCount:
+			count := permissionCountQuery(s)
+			key, value = func(b *sql.Builder) { b.WriteString(count) }, cursor.Value
+		}
+
+		s.Where(sql.Or(
+			sql.P(func(b *sql.Builder) {
+				key(b)
+				b.WriteOp(op).Arg(value)
+			}),
+			sql.And(
+				sql.P(func(b *sql.Builder) {
+					key(b)
+					b.WriteOp(sql.OpEQ).Arg(value)
+				}),
+				sql.GT(s.C(role.FieldName), cursor.Name),
+			),
+		))
+	}
+}
+
+// rolesAt returns a page of the roles matching the filters of cmd at
+// cmd.AsOf as recorded by their latest revisions at that time. The roles
+// granting the permissions are resolved from the grants valid at that time,
+// the remaining filters and the order are applied in memory.
+func (l *ListRolesHandler) rolesAt(ctx context.Context, cmd ListRoles, by RoleSort, pageSize int, cursor *rolesCursor, expansions []PermissionExpansion) (*RolePage, error) {
+	asOf := cmd.AsOf.UTC()
+
+	inStages, err := stageFilter(cmd.Stages, cmd.ExcludeStages)
+	if err != nil {
+		return nil, err
+	}
+
+	preds, err := scopePredicates(cmd.Scope, cmd.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.NamePrefix != "" {
+		preds = append(preds, role.NameHasPrefix(cmd.NamePrefix))
+	}
+
+	if len(cmd.Permissions) > 0 {
+		switch cmd.Match {
+		case MatchAll, "":
+			preds = append(preds, grantedAllAt(asOf, cmd.Permissions...))
+		case MatchAny:
+			preds = append(preds, grantedAnyAt(asOf, cmd.Permissions...))
+		default:
+			return nil, apperr.InvalidArgument("invalid match %q", cmd.Match)
+		}
+
+		if err := checkGrantsAt(ctx, l.client, asOf); err != nil {
+			return nil, err
+		}
+	}
+
+	revisions, err := revisionsAt(ctx, l.client, asOf, preds...)
+	if err != nil {
+		return nil, err
+	}
+
+	type entry struct {
+		key     rolesCursor
+		summary RoleSummary
+	}
+
+	var entries []entry
+	for _, rev := range revisions {
+		if rev.Deleted && !cmd.IncludeDeleted || !inStages(rev.Stage.String()) {
+			continue
+		}
+
+		if cmd.Title != "" && !strings.Contains(strings.ToLower(rev.Title), strings.ToLower(cmd.Title)) {
+			continue
+		}
+
+		if cmd.Service != "" && !grantsService(rev.Permissions, cmd.Service) {
+			continue
+		}
+
+		r := revisionRole(rev)
+		e := entry{
+			key: rolesCursor{Sort: by, Descending: cmd.Descending, Name: r.Name, Title: r.Title},
+			summary: RoleSummary{
+				Name:            r.Name,
+				Title:           r.Title,
+				Stage:           r.Stage,
+				Scope:           r.Scope,
+				DeletedAt:       r.DeletedAt,
+				PermissionCount: len(r.Permissions),
+			},
+		}
+
+		switch by {
+		case RoleSortStage:
+			e.key.Value = stageOrder[role.Stage(r.Stage)]
+		case RoleSortPermissionCount:
+			e.key.Value = len(r.Permissions)
+		}
+
+		if cursor == nil || cursor.before(e.key) {
+			entries = append(entries, e)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key.before(entries[j].key)
+	})
+
+	page := &RolePage{Roles: []RoleSummary{}, Expansions: expansions}
+	for i, e := range entries {
+		if i == pageSize {
+			page.NextPageToken = entries[i-1].key.encode()
+			break
+		}
+
+		page.Roles = append(page.Roles, e.summary)
+	}
+
+	return page, nil
+}
+
+// before reports whether the role at c is ordered before the role at o,
+// the same way as orderRoles orders them.
+func (c rolesCursor) before(o rolesCursor) bool {
+	switch c.Sort {
+	case RoleSortName:
+		if c.Descending {
+			return c.Name > o.Name
+		}
+		return c.Name < o.Name
+	case RoleSortTitle:
+		if c.Title != o.Title {
+			return (c.Title < o.Title) != c.Descending
+		}
+	default:
+		if c.Value != o.Value {
+			return (c.Value < o.Value) != c.Descending
+		}
+	}
+
+	return c.Name < o.Name
+}
+
+// grantsService reports whether any of the permissions belongs to the named service.
+func grantsService(permissions []string, name string) bool {
+	for _, p := range permissions {
+		if p == name || strings.HasPrefix(p, name+".") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/service"
+)
+
+type ListServices struct{}
+
+type ListServicesHandler struct {
+	client *ent.Client
+}
+
+func NewListServicesHandler(client *ent.Client) *ListServicesHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &ListServicesHandler{client: client}
+}
+
+// Handle returns every service ordered by name.
+func (l *ListServicesHandler) Handle(ctx context.Context, _ ListServices) (_ []Service, err error) {
+	fmt.Println("listing services")
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Println("failed to list services")
+			return
+		}
+
+		fmt.Println("succesfully listed services")
+	}()
+
+	services, err := l.client.Service.Query().
+		WithResourceTypes().
+		Order(ent.Asc(service.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var v []struct {
+		ID    int `json:"id"`
+		Count int `json:"count"`
+	}
+
+	err = l.client.Service.Query().
+		GroupBy(service.FieldID).
+		Aggregate(func(s *sql.Selector) string {
+			// only select the foreign key as the id of the group is not qualified
+			builder := sql.Dialect(s.Dialect())
+			t := builder.Select(service.PermissionsColumn).From(builder.Table(service.PermissionsTable)).As("p")
+			s.Join(t).On(s.C(service.FieldID), t.C(service.PermissionsColumn))
+			return sql.As(sql.Count(t.C(service.PermissionsColumn)), "count")
+		}).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int, len(v))
+	for _, c := range v {
+		counts[c.ID] = c.Count
+	}
+
+	result := make([]Service, len(services))
+	for i, s := range services {
+		result[i] = Service{
+			Name:              s.Name,
+			ResourceTypeCount: len(s.Edges.ResourceTypes),
+			PermissionCount:   counts[s.ID],
+		}
+	}
+
+	return result, nil
+}
+
+// servicePredicates limits the roles to those granting permissions of the
+// named service, if set.
+func servicePredicates(name string) []predicate.Role {
+	if name == "" {
+		return nil
+	}
+
+	return []predicate.Role{role.HasPermissionsWith(permission.HasServiceWith(service.Name(name)))}
+}
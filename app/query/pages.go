@@ -0,0 +1,20 @@
+package query
+
+import "github.com/rosstimothy/iam/app/apperr"
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// validPageSize validates the requested page size, defaultPageSize if not set.
+func validPageSize(requested int) (int, error) {
+	switch {
+	case requested < 0 || requested > maxPageSize:
+		return 0, apperr.InvalidArgument("page size must be between 1 and %d", maxPageSize)
+	case requested == 0:
+		return defaultPageSize, nil
+	}
+
+	return requested, nil
+}
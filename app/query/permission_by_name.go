@@ -0,0 +1,110 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/role"
+)
+
+type PermissionByName struct {
+	Permission string
+	// IncludeDeleted lists the roles which are deleted upstream as well.
+	IncludeDeleted bool
+	// AsOf optionally lists the roles which granted the permission at
+	// the given time instead of those which grant it now.
+	AsOf *time.Time
+}
+
+type PermissionByNameHandler struct {
+	client *ent.Client
+}
+
+func NewPermissionByNameHandler(client *ent.Client) *PermissionByNameHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &PermissionByNameHandler{client: client}
+}
+
+func (l *PermissionByNameHandler) Handle(ctx context.Context, cmd PermissionByName) (_ *Permission, err error) {
+	fmt.Printf("looking for permission named %s\n", cmd.Permission)
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to find permission named %s\n", cmd.Permission)
+			return
+		}
+
+		fmt.Printf("succesfully found permission named %s\n", cmd.Permission)
+	}()
+
+	if cmd.AsOf != nil {
+		return l.permissionAt(ctx, cmd.Permission, cmd.AsOf.UTC(), cmd.IncludeDeleted)
+	}
+
+	entPermission, err := l.client.Permission.
+		Query().
+		Where(permission.Name(cmd.Permission)).
+		WithRoles(func(q *ent.RoleQuery) {
+			q.Where(notDeleted(cmd.IncludeDeleted)...).Order(ent.Asc(role.FieldName))
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, notFoundError(ctx, l.client, "permission %s not found", cmd.Permission)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Permission{
+		Name:      entPermission.Name,
+		RoleCount: len(entPermission.Edges.Roles),
+		Roles:     make([]string, len(entPermission.Edges.Roles)),
+	}
+
+	for i, r := range entPermission.Edges.Roles {
+		p.Roles[i] = r.Name
+	}
+
+	return p, nil
+}
+
+// permissionAt returns the permission named name with the roles which
+// granted it at asOf.
+func (l *PermissionByNameHandler) permissionAt(ctx context.Context, name string, asOf time.Time, includeDeleted bool) (*Permission, error) {
+	exists, err := l.client.Permission.Query().Where(permission.Name(name)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, notFoundError(ctx, l.client, "permission %s not found", name)
+	}
+
+	if err := checkGrantsAt(ctx, l.client, asOf); err != nil {
+		return nil, err
+	}
+
+	revisions, err := revisionsAt(ctx, l.client, asOf, grantedAnyAt(asOf, name))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Permission{Name: name, Roles: []string{}}
+	for _, rev := range revisions {
+		if !rev.Deleted || includeDeleted {
+			p.Roles = append(p.Roles, rev.Edges.Role.Name)
+		}
+	}
+
+	sort.Strings(p.Roles)
+	p.RoleCount = len(p.Roles)
+
+	return p, nil
+}
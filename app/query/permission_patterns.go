@@ -0,0 +1,109 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/rosstimothy/iam/app/glob"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/predicate"
+)
+
+// PermissionExpansion lists the permissions matched by a pattern.
+type PermissionExpansion struct {
+	Pattern     string   `json:"pattern"`
+	Permissions []string `json:"permissions"`
+}
+
+func isPermissionPattern(p string) bool {
+	return strings.ContainsAny(p, "*?")
+}
+
+// expandPermissions replaces the glob patterns among permissions, where * matches
+// any number of characters and ? matches a single character, with the permissions
+// they match. Exact names are kept as is even if they are unknown.
+func expandPermissions(ctx context.Context, client *ent.Client, permissions []string) ([]string, []PermissionExpansion, error) {
+	var expanded []string
+	var expansions []PermissionExpansion
+	seen := map[string]bool{}
+	add := func(p string) {
+		if !seen[p] {
+			seen[p] = true
+			expanded = append(expanded, p)
+		}
+	}
+
+	for _, p := range permissions {
+		if !isPermissionPattern(p) {
+			add(p)
+			continue
+		}
+
+		matches, err := matchPermissions(ctx, client, p)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(matches) == 0 {
+			return nil, nil, notFoundError(ctx, client, "no permissions match %s", p)
+		}
+
+		for _, m := range matches {
+			add(m)
+		}
+		expansions = append(expansions, PermissionExpansion{Pattern: p, Permissions: matches})
+	}
+
+	return expanded, expansions, nil
+}
+
+// matchPermissions returns the names of the permissions matching the glob pattern.
+func matchPermissions(ctx context.Context, client *ent.Client, pattern string) ([]string, error) {
+	names, err := client.Permission.Query().
+		Where(nameLike(pattern)).
+		Order(ent.Asc(permission.FieldName)).
+		Select(permission.FieldName).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// LIKE is case insensitive in sqlite and mysql
+	re := glob.Regexp(pattern)
+	matches := names[:0]
+	for _, name := range names {
+		if re.MatchString(name) {
+			matches = append(matches, name)
+		}
+	}
+
+	return matches, nil
+}
+
+// nameLike matches the permissions whose name is LIKE the glob pattern.
+func nameLike(pattern string) predicate.Permission {
+	var b strings.Builder
+	for _, c := range pattern {
+		switch c {
+		case '*':
+			b.WriteRune('%')
+		case '?':
+			b.WriteRune('_')
+		case '%', '_', '!':
+			b.WriteRune('!')
+			b.WriteRune(c)
+		default:
+			b.WriteRune(c)
+		}
+	}
+
+	like := b.String()
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(permission.FieldName)).WriteString(" LIKE ").Arg(like).WriteString(" ESCAPE '!'")
+		}))
+	})
+}
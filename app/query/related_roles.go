@@ -0,0 +1,118 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/role"
+)
+
+// Relation identifies a direction in the role containment hierarchy.
+type Relation string
+
+const (
+	// RelationSupersets are the smallest roles granting every permission of a role and more.
+	RelationSupersets Relation = "supersets"
+	// RelationSubsets are the largest roles granting only permissions of a role.
+	RelationSubsets Relation = "subsets"
+)
+
+type RelatedRoles struct {
+	Role     string
+	Relation Relation
+	// Service optionally limits the related roles to those granting permissions of the service.
+	Service string
+	// Stages optionally limits the related roles to those in the given stages.
+	Stages []string
+	// ExcludeStages optionally leaves out the related roles in the given stages.
+	ExcludeStages []string
+}
+
+type RelatedRolesHandler struct {
+	client *ent.Client
+}
+
+func NewRelatedRolesHandler(client *ent.Client) *RelatedRolesHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &RelatedRolesHandler{client: client}
+}
+
+// Handle returns the direct supersets or subsets of a role. Deleted roles
+// are not part of the role hierarchy.
+func (l *RelatedRolesHandler) Handle(ctx context.Context, cmd RelatedRoles) (_ []RoleSummary, err error) {
+	fmt.Printf("looking for %s of role %s\n", cmd.Relation, cmd.Role)
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to find %s of role %s\n", cmd.Relation, cmd.Role)
+			return
+		}
+
+		fmt.Printf("succesfully found %s of role %s\n", cmd.Relation, cmd.Role)
+	}()
+
+	stages, err := stagePredicates(cmd.Stages, cmd.ExcludeStages)
+	if err != nil {
+		return nil, err
+	}
+
+	entRole, err := l.client.Role.
+		Query().
+		Where(role.Name(cmd.Role)).
+		Where(notDeleted(false)...).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, notFoundError(ctx, l.client, "role %s not found", cmd.Role)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var query *ent.RoleQuery
+	switch cmd.Relation {
+	case RelationSupersets:
+		query = entRole.QuerySupersets()
+	case RelationSubsets:
+		query = entRole.QuerySubsets()
+	default:
+		return nil, apperr.InvalidArgument("invalid relation %q", cmd.Relation)
+	}
+
+	roles, err := query.
+		Where(stages...).
+		Where(servicePredicates(cmd.Service)...).
+		Order(ent.Asc(role.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(roles))
+	for i, r := range roles {
+		ids[i] = r.ID
+	}
+
+	counts, err := permissionCounts(ctx, l.client, role.IDIn(ids...))
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]RoleSummary, len(roles))
+	for i, r := range roles {
+		summaries[i] = RoleSummary{
+			Name:            r.Name,
+			Title:           r.Title,
+			Stage:           r.Stage.String(),
+			Scope:           r.Scope.String(),
+			DeletedAt:       r.DeletedAt,
+			PermissionCount: counts[r.ID],
+		}
+	}
+
+	return summaries, nil
+}
@@ -4,13 +4,20 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"time"
 
+	"github.com/rosstimothy/iam/app/apperr"
 	"github.com/rosstimothy/iam/ent"
 	"github.com/rosstimothy/iam/ent/role"
 )
 
 type RoleByName struct {
 	Role string
+	// IncludeDeleted finds the role even if it is deleted upstream.
+	IncludeDeleted bool
+	// AsOf optionally retrieves the role as it was at the given time
+	// instead of its current state.
+	AsOf *time.Time
 }
 
 type RoleByNameHandler struct {
@@ -28,6 +35,7 @@ func NewRoleByNameHandler(client *ent.Client) *RoleByNameHandler {
 func (l *RoleByNameHandler) Handle(ctx context.Context, cmd RoleByName) (_ *Role, err error) {
 	fmt.Printf("looking for roles named %s\n", cmd.Role)
 	defer func() {
+		err = wrapError(err)
 		if err != nil {
 			fmt.Printf("failed to find roles named %s\n", cmd.Role)
 			return
@@ -36,11 +44,28 @@ func (l *RoleByNameHandler) Handle(ctx context.Context, cmd RoleByName) (_ *Role
 		fmt.Printf("succesfully found roles named %s\n", cmd.Role)
 	}()
 
+	if cmd.AsOf != nil {
+		return l.roleAt(ctx, cmd.Role, cmd.AsOf.UTC(), cmd.IncludeDeleted)
+	}
+
 	entRole, err := l.client.Role.
 		Query().
 		Where(role.Name(cmd.Role)).
+		Where(notDeleted(cmd.IncludeDeleted)...).
 		WithPermissions().
 		Only(ctx)
+	if ent.IsNotFound(err) {
+		deleted, err := l.client.Role.Query().Where(role.Name(cmd.Role), role.DeletedAtNotNil()).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if deleted {
+			return nil, apperr.NotFound("role %s is deleted, include deleted roles to retrieve it", cmd.Role)
+		}
+
+		return nil, notFoundError(ctx, l.client, "role %s not found", cmd.Role)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -50,8 +75,11 @@ func (l *RoleByNameHandler) Handle(ctx context.Context, cmd RoleByName) (_ *Role
 		Title:       entRole.Title,
 		Description: entRole.Description,
 		Permissions: nil,
-		Stage:       entRole.Stage,
+		Stage:       entRole.Stage.String(),
 		Etag:        hex.EncodeToString(entRole.Etag),
+		Scope:       entRole.Scope.String(),
+		DeletedAt:   entRole.DeletedAt,
+		Parent:      entRole.Parent,
 	}
 
 	r.Permissions = make([]string, len(entRole.Edges.Permissions))
@@ -61,3 +89,22 @@ func (l *RoleByNameHandler) Handle(ctx context.Context, cmd RoleByName) (_ *Role
 
 	return r, nil
 }
+
+// roleAt returns the role named name as recorded by its latest revision at asOf.
+func (l *RoleByNameHandler) roleAt(ctx context.Context, name string, asOf time.Time, includeDeleted bool) (*Role, error) {
+	revisions, err := revisionsAt(ctx, l.client, asOf, role.Name(name))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rev := range revisions {
+		if rev.Deleted && !includeDeleted {
+			return nil, apperr.NotFound("role %s was deleted at %s, include deleted roles to retrieve it", name, rev.CreatedAt.Format(time.RFC3339))
+		}
+
+		r := revisionRole(rev)
+		return &r, nil
+	}
+
+	return nil, notFoundError(ctx, l.client, "role %s not found at %s", name, asOf.Format(time.RFC3339))
+}
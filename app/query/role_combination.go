@@ -0,0 +1,443 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/role"
+)
+
+// maxCombinationSearch bounds the number of combinations explored
+// while proving a combination is minimal.
+const maxCombinationSearch = 100000
+
+// maxCombinationSolutions bounds the number of equally small
+// combinations collected before they are ranked.
+const maxCombinationSolutions = 100
+
+type MinimalRoleCombination struct {
+	// Permissions are the names of the permissions, or glob patterns
+	// matching them such as storage.objects.*.
+	Permissions []string
+	// Stages optionally limits the candidate roles to those in the given stages.
+	Stages []string
+	// ExcludeStages leaves out the candidate roles in the given stages,
+	// DEPRECATED and ALPHA if nil and no Stages are given.
+	ExcludeStages []string
+	// IncludeDeleted includes the roles which are deleted upstream.
+	IncludeDeleted bool
+	// Scope optionally limits the candidate roles to those of the given scope.
+	Scope string
+	// Parent optionally limits the candidate roles to those defined by the given parent.
+	Parent string
+	// Service optionally limits the candidate roles to those granting permissions of the service.
+	Service string
+	// Limit is the maximum number of solutions returned, 1 if not set.
+	Limit int
+	// IncludeExcess populates the excess permissions granted by each solution.
+	IncludeExcess bool
+}
+
+// RoleCombination is a set of roles which together grant the requested permissions.
+type RoleCombination struct {
+	Roles       []string `json:"roles"`
+	ExcessCount int      `json:"excess_count"`
+	Excess      []string `json:"excess,omitempty"`
+}
+
+type RoleCombinations struct {
+	// Exact is true if the solutions are known to be the smallest possible
+	// combinations, false if the search was cut short and the solutions
+	// may contain more roles than required.
+	Exact bool `json:"exact"`
+	// Uncovered are the requested permissions not granted by any candidate role.
+	Uncovered []string          `json:"uncovered,omitempty"`
+	Solutions []RoleCombination `json:"solutions"`
+	// Expansions are the permissions matched by the requested patterns.
+	Expansions []PermissionExpansion `json:"expansions,omitempty"`
+}
+
+type MinimalRoleCombinationHandler struct {
+	client *ent.Client
+}
+
+func NewMinimalRoleCombinationHandler(client *ent.Client) *MinimalRoleCombinationHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &MinimalRoleCombinationHandler{client: client}
+}
+
+// Handle returns the smallest combinations of roles which together grant all
+// requested permissions, ordered by the number of excess permissions they grant.
+func (l *MinimalRoleCombinationHandler) Handle(ctx context.Context, cmd MinimalRoleCombination) (_ *RoleCombinations, err error) {
+	fmt.Printf("combining roles with permissions %s\n", cmd.Permissions)
+
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to combine roles with permissions %s\n", cmd.Permissions)
+			return
+		}
+
+		fmt.Printf("succesfully combined roles with permissions %s\n", cmd.Permissions)
+	}()
+
+	if len(cmd.Permissions) == 0 {
+		return nil, apperr.InvalidArgument("no permissions provided")
+	}
+
+	limit := cmd.Limit
+	if limit <= 0 {
+		limit = 1
+	}
+
+	permissions, expansions, err := expandPermissions(ctx, l.client, cmd.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	preds, err := recommendedStages(cmd.Stages, cmd.ExcludeStages)
+	if err != nil {
+		return nil, err
+	}
+	preds = append(preds, notDeleted(cmd.IncludeDeleted)...)
+	preds = append(preds, role.HasPermissionsWith(permission.NameIn(permissions...)))
+
+	scopePreds, err := scopePredicates(cmd.Scope, cmd.Parent)
+	if err != nil {
+		return nil, err
+	}
+	preds = append(preds, scopePreds...)
+	preds = append(preds, servicePredicates(cmd.Service)...)
+
+	roles, err := l.client.Role.Query().
+		Where(preds...).
+		WithPermissions().
+		Order(ent.Asc(role.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s := newCoverSolver(permissions, roles)
+	result := &RoleCombinations{Uncovered: s.uncovered(), Expansions: expansions}
+
+	solutions, exact := s.solve()
+	result.Exact = exact
+
+	combinations := make([]RoleCombination, len(solutions))
+	for i, solution := range solutions {
+		combinations[i] = s.combination(solution)
+	}
+
+	sort.SliceStable(combinations, func(i, j int) bool {
+		return combinations[i].ExcessCount < combinations[j].ExcessCount
+	})
+
+	if len(combinations) > limit {
+		combinations = combinations[:limit]
+	}
+
+	if !cmd.IncludeExcess {
+		for i := range combinations {
+			combinations[i].Excess = nil
+		}
+	}
+
+	result.Solutions = combinations
+
+	return result, nil
+}
+
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << (uint(i) % 64)
+}
+
+func (b bitset) has(i int) bool {
+	return b[i/64]&(1<<(uint(i)%64)) != 0
+}
+
+func (b bitset) count() int {
+	n := 0
+	for _, w := range b {
+		n += bits.OnesCount64(w)
+	}
+
+	return n
+}
+
+func (b bitset) union(o bitset) bitset {
+	u := make(bitset, len(b))
+	for i := range b {
+		u[i] = b[i] | o[i]
+	}
+
+	return u
+}
+
+// missing returns the number of bits set in o which are not set in b.
+func (b bitset) missing(o bitset) int {
+	n := 0
+	for i := range b {
+		n += bits.OnesCount64(o[i] &^ b[i])
+	}
+
+	return n
+}
+
+// subsetOf reports whether every bit set in b is set in o.
+func (b bitset) subsetOf(o bitset) bool {
+	for i := range b {
+		if b[i]&^o[i] != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// coverSolver finds the smallest sets of roles whose permissions
+// cover the required permissions.
+type coverSolver struct {
+	required   []string
+	roles      []*ent.Role
+	covers     []bitset
+	excess     []int
+	coverers   [][]int
+	coverable  bitset
+	nodes      int
+	best       int
+	solutions  map[string][]int
+	exhausted  bool
+	candidates []int
+}
+
+func newCoverSolver(permissions []string, roles []*ent.Role) *coverSolver {
+	index := map[string]int{}
+	var required []string
+	for _, p := range permissions {
+		if _, ok := index[p]; !ok {
+			index[p] = len(required)
+			required = append(required, p)
+		}
+	}
+
+	s := &coverSolver{
+		required:  required,
+		roles:     roles,
+		covers:    make([]bitset, len(roles)),
+		excess:    make([]int, len(roles)),
+		coverers:  make([][]int, len(required)),
+		coverable: newBitset(len(required)),
+	}
+
+	for i, r := range roles {
+		s.covers[i] = newBitset(len(required))
+		for _, p := range r.Edges.Permissions {
+			if j, ok := index[p.Name]; ok {
+				s.covers[i].set(j)
+			} else {
+				s.excess[i]++
+			}
+		}
+	}
+
+	// Roles which cover no more than another role while granting at least
+	// as many excess permissions can never improve a solution.
+	for i := range roles {
+		dominated := false
+		for j := range roles {
+			if i == j || !s.covers[i].subsetOf(s.covers[j]) || s.excess[i] < s.excess[j] {
+				continue
+			}
+
+			if s.covers[i].count() < s.covers[j].count() || s.excess[i] > s.excess[j] {
+				dominated = true
+				break
+			}
+		}
+
+		if !dominated {
+			s.candidates = append(s.candidates, i)
+		}
+	}
+
+	for _, i := range s.candidates {
+		for j := range required {
+			if s.covers[i].has(j) {
+				s.coverers[j] = append(s.coverers[j], i)
+				s.coverable.set(j)
+			}
+		}
+	}
+
+	return s
+}
+
+func (s *coverSolver) uncovered() []string {
+	var uncovered []string
+	for j, p := range s.required {
+		if !s.coverable.has(j) {
+			uncovered = append(uncovered, p)
+		}
+	}
+
+	return uncovered
+}
+
+// solve returns the smallest combinations of candidate roles which cover all
+// coverable permissions and whether they were proven to be minimal.
+func (s *coverSolver) solve() ([][]int, bool) {
+	greedy := s.greedy()
+	if len(greedy) == 0 {
+		return nil, true
+	}
+
+	s.best = len(greedy)
+	s.solutions = map[string][]int{}
+	s.record(greedy)
+	s.search(newBitset(len(s.required)), nil)
+
+	solutions := make([][]int, 0, len(s.solutions))
+	for _, solution := range s.solutions {
+		solutions = append(solutions, solution)
+	}
+
+	sort.Slice(solutions, func(i, j int) bool {
+		return solutionKey(solutions[i]) < solutionKey(solutions[j])
+	})
+
+	return solutions, !s.exhausted
+}
+
+// greedy repeatedly picks the candidate covering the most uncovered
+// permissions, preferring fewer excess permissions.
+func (s *coverSolver) greedy() []int {
+	covered := newBitset(len(s.required))
+	var chosen []int
+	for covered.missing(s.coverable) > 0 {
+		best, bestGain := -1, 0
+		for _, i := range s.candidates {
+			gain := covered.missing(s.covers[i])
+			if gain > bestGain || (gain == bestGain && gain > 0 && s.excess[i] < s.excess[best]) {
+				best, bestGain = i, gain
+			}
+		}
+
+		chosen = append(chosen, best)
+		covered = covered.union(s.covers[best])
+	}
+
+	return chosen
+}
+
+// search is a branch and bound over the candidates covering the uncovered
+// permission with the fewest candidates. It collects every combination
+// no larger than the best found until the search budget is exhausted.
+func (s *coverSolver) search(covered bitset, chosen []int) {
+	if s.nodes >= maxCombinationSearch {
+		s.exhausted = true
+		return
+	}
+	s.nodes++
+
+	remaining := covered.missing(s.coverable)
+	if remaining == 0 {
+		s.record(chosen)
+		return
+	}
+
+	maxGain := 0
+	for _, i := range s.candidates {
+		if gain := covered.missing(s.covers[i]); gain > maxGain {
+			maxGain = gain
+		}
+	}
+
+	if lowerBound := (remaining + maxGain - 1) / maxGain; len(chosen)+lowerBound > s.best {
+		return
+	}
+
+	next := -1
+	for j := range s.required {
+		if !s.coverable.has(j) || covered.has(j) {
+			continue
+		}
+
+		if next < 0 || len(s.coverers[j]) < len(s.coverers[next]) {
+			next = j
+		}
+	}
+
+	options := append([]int(nil), s.coverers[next]...)
+	sort.SliceStable(options, func(a, b int) bool {
+		return covered.missing(s.covers[options[a]]) > covered.missing(s.covers[options[b]])
+	})
+
+	for _, i := range options {
+		s.search(covered.union(s.covers[i]), append(chosen[:len(chosen):len(chosen)], i))
+	}
+}
+
+func (s *coverSolver) record(chosen []int) {
+	if len(chosen) < s.best {
+		s.best = len(chosen)
+		s.solutions = map[string][]int{}
+	}
+
+	if len(chosen) > s.best || len(s.solutions) >= maxCombinationSolutions {
+		return
+	}
+
+	solution := append([]int(nil), chosen...)
+	sort.Ints(solution)
+	s.solutions[solutionKey(solution)] = solution
+}
+
+func solutionKey(solution []int) string {
+	var b strings.Builder
+	for _, i := range solution {
+		fmt.Fprintf(&b, "%08d", i)
+	}
+
+	return b.String()
+}
+
+func (s *coverSolver) combination(solution []int) RoleCombination {
+	required := make(map[string]bool, len(s.required))
+	for _, p := range s.required {
+		required[p] = true
+	}
+
+	excess := map[string]bool{}
+	c := RoleCombination{Roles: make([]string, len(solution))}
+	for i, idx := range solution {
+		c.Roles[i] = s.roles[idx].Name
+		for _, p := range s.roles[idx].Edges.Permissions {
+			if !required[p.Name] && !excess[p.Name] {
+				excess[p.Name] = true
+				c.Excess = append(c.Excess, p.Name)
+			}
+		}
+	}
+
+	sort.Strings(c.Roles)
+	sort.Strings(c.Excess)
+	c.ExcessCount = len(c.Excess)
+
+	return c
+}
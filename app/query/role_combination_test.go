@@ -0,0 +1,104 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rosstimothy/iam/ent"
+)
+
+func solverRole(name string, permissions ...string) *ent.Role {
+	r := &ent.Role{Name: name}
+	for _, p := range permissions {
+		r.Edges.Permissions = append(r.Edges.Permissions, &ent.Permission{Name: p})
+	}
+
+	return r
+}
+
+// solve returns the combinations found by a cover solver over roles.
+func solve(t *testing.T, permissions []string, roles ...*ent.Role) ([]RoleCombination, []string) {
+	t.Helper()
+
+	s := newCoverSolver(permissions, roles)
+	solutions, exact := s.solve()
+	if !exact {
+		t.Fatalf("solutions %v are not exact", solutions)
+	}
+
+	combinations := make([]RoleCombination, len(solutions))
+	for i, solution := range solutions {
+		combinations[i] = s.combination(solution)
+	}
+
+	return combinations, s.uncovered()
+}
+
+func TestCoverSolverFindsMinimalCombination(t *testing.T) {
+	// greedily picking the largest role needs three roles, a and b suffice
+	combinations, uncovered := solve(t,
+		[]string{"p.1", "p.2", "p.3", "p.4", "p.5", "p.6"},
+		solverRole("roles/largest", "p.1", "p.2", "p.3", "p.4"),
+		solverRole("roles/a", "p.1", "p.2", "p.5"),
+		solverRole("roles/b", "p.3", "p.4", "p.6"),
+		solverRole("roles/c", "p.5"),
+		solverRole("roles/d", "p.6"),
+	)
+
+	want := []RoleCombination{{Roles: []string{"roles/a", "roles/b"}}}
+	if !reflect.DeepEqual(combinations, want) {
+		t.Errorf("got combinations %+v, want %+v", combinations, want)
+	}
+
+	if len(uncovered) != 0 {
+		t.Errorf("got uncovered %q, want none", uncovered)
+	}
+}
+
+func TestCoverSolverReportsUncoveredPermissions(t *testing.T) {
+	combinations, uncovered := solve(t,
+		[]string{"p.1", "p.missing", "p.2", "p.1"},
+		solverRole("roles/a", "p.1", "p.2", "p.other"),
+	)
+
+	want := []RoleCombination{{Roles: []string{"roles/a"}, ExcessCount: 1, Excess: []string{"p.other"}}}
+	if !reflect.DeepEqual(combinations, want) {
+		t.Errorf("got combinations %+v, want %+v", combinations, want)
+	}
+
+	if !reflect.DeepEqual(uncovered, []string{"p.missing"}) {
+		t.Errorf("got uncovered %q, want p.missing", uncovered)
+	}
+}
+
+func TestCoverSolverSkipsDominatedRoles(t *testing.T) {
+	combinations, _ := solve(t,
+		[]string{"p.1", "p.2"},
+		// covers the same as a with more excess
+		solverRole("roles/dominated", "p.1", "p.2", "p.3", "p.4"),
+		solverRole("roles/a", "p.1", "p.2", "p.3"),
+		// covers the same as a with the same excess
+		solverRole("roles/b", "p.1", "p.2", "p.5"),
+		// covers less than a without less excess
+		solverRole("roles/partial", "p.1", "p.6"),
+	)
+
+	want := []RoleCombination{
+		{Roles: []string{"roles/a"}, ExcessCount: 1, Excess: []string{"p.3"}},
+		{Roles: []string{"roles/b"}, ExcessCount: 1, Excess: []string{"p.5"}},
+	}
+	if !reflect.DeepEqual(combinations, want) {
+		t.Errorf("got combinations %+v, want %+v", combinations, want)
+	}
+}
+
+func TestCoverSolverWithoutRoles(t *testing.T) {
+	combinations, uncovered := solve(t, []string{"p.1"})
+	if len(combinations) != 0 {
+		t.Errorf("got combinations %+v, want none", combinations)
+	}
+
+	if !reflect.DeepEqual(uncovered, []string{"p.1"}) {
+		t.Errorf("got uncovered %q, want p.1", uncovered)
+	}
+}
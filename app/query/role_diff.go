@@ -0,0 +1,114 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+type RoleDiff struct {
+	A string
+	B string
+	// IncludeDeleted compares roles even if they are deleted upstream.
+	IncludeDeleted bool
+}
+
+// FieldDiff is an attribute which differs between two roles.
+type FieldDiff struct {
+	Field string `json:"field"`
+	A     string `json:"a"`
+	B     string `json:"b"`
+}
+
+type RoleDifference struct {
+	A       string      `json:"a"`
+	B       string      `json:"b"`
+	Fields  []FieldDiff `json:"fields"`
+	OnlyInA []string    `json:"only_in_a"`
+	OnlyInB []string    `json:"only_in_b"`
+	Shared  []string    `json:"shared"`
+}
+
+type RoleDiffHandler struct {
+	roleByName *RoleByNameHandler
+}
+
+func NewRoleDiffHandler(roleByName *RoleByNameHandler) *RoleDiffHandler {
+	if roleByName == nil {
+		panic("nil roleByName")
+	}
+
+	return &RoleDiffHandler{roleByName: roleByName}
+}
+
+// Handle compares the attributes and permissions of two roles.
+func (l *RoleDiffHandler) Handle(ctx context.Context, cmd RoleDiff) (_ *RoleDifference, err error) {
+	fmt.Printf("comparing roles %s and %s\n", cmd.A, cmd.B)
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to compare roles %s and %s\n", cmd.A, cmd.B)
+			return
+		}
+
+		fmt.Printf("succesfully compared roles %s and %s\n", cmd.A, cmd.B)
+	}()
+
+	a, err := l.roleByName.Handle(ctx, RoleByName{Role: cmd.A, IncludeDeleted: cmd.IncludeDeleted})
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := l.roleByName.Handle(ctx, RoleByName{Role: cmd.B, IncludeDeleted: cmd.IncludeDeleted})
+	if err != nil {
+		return nil, err
+	}
+
+	d := &RoleDifference{
+		A:       a.Name,
+		B:       b.Name,
+		Fields:  []FieldDiff{},
+		OnlyInA: []string{},
+		OnlyInB: []string{},
+		Shared:  []string{},
+	}
+
+	fields := []FieldDiff{
+		{Field: "title", A: a.Title, B: b.Title},
+		{Field: "description", A: a.Description, B: b.Description},
+		{Field: "stage", A: a.Stage, B: b.Stage},
+		{Field: "scope", A: a.Scope, B: b.Scope},
+	}
+	for _, f := range fields {
+		if f.A != f.B {
+			d.Fields = append(d.Fields, f)
+		}
+	}
+
+	inB := make(map[string]bool, len(b.Permissions))
+	for _, p := range b.Permissions {
+		inB[p] = true
+	}
+
+	inA := make(map[string]bool, len(a.Permissions))
+	for _, p := range a.Permissions {
+		inA[p] = true
+		if inB[p] {
+			d.Shared = append(d.Shared, p)
+		} else {
+			d.OnlyInA = append(d.OnlyInA, p)
+		}
+	}
+
+	for _, p := range b.Permissions {
+		if !inA[p] {
+			d.OnlyInB = append(d.OnlyInB, p)
+		}
+	}
+
+	sort.Strings(d.OnlyInA)
+	sort.Strings(d.OnlyInB)
+	sort.Strings(d.Shared)
+
+	return d, nil
+}
@@ -0,0 +1,110 @@
+package query
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolerevision"
+)
+
+type RoleHistory struct {
+	Role string
+	// Stages optionally limits the revisions to those in the given stages.
+	Stages []string
+	// ExcludeStages optionally leaves out the revisions in the given stages.
+	ExcludeStages []string
+}
+
+type RoleHistoryHandler struct {
+	client *ent.Client
+}
+
+func NewRoleHistoryHandler(client *ent.Client) *RoleHistoryHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &RoleHistoryHandler{client: client}
+}
+
+// Handle returns the revisions of a role, oldest first, including
+// the revisions of roles which are deleted upstream. The permissions
+// added and removed by a revision are relative to the previous revision,
+// even if the stage filters leave the previous revision out.
+func (l *RoleHistoryHandler) Handle(ctx context.Context, cmd RoleHistory) (_ []RoleRevision, err error) {
+	fmt.Printf("looking for history of role %s\n", cmd.Role)
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to find history of role %s\n", cmd.Role)
+			return
+		}
+
+		fmt.Printf("succesfully found history of role %s\n", cmd.Role)
+	}()
+
+	inStages, err := stageFilter(cmd.Stages, cmd.ExcludeStages)
+	if err != nil {
+		return nil, err
+	}
+
+	entRole, err := l.client.Role.Query().Where(role.Name(cmd.Role)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, notFoundError(ctx, l.client, "role %s not found", cmd.Role)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := entRole.QueryRevisions().Order(ent.Asc(rolerevision.FieldRevision)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]RoleRevision, 0, len(revisions))
+	for i, rev := range revisions {
+		if !inStages(rev.Stage.String()) {
+			continue
+		}
+
+		revision := RoleRevision{
+			Revision:    rev.Revision,
+			CreatedAt:   rev.CreatedAt,
+			Title:       rev.Title,
+			Description: rev.Description,
+			Stage:       rev.Stage.String(),
+			Etag:        hex.EncodeToString(rev.Etag),
+			Deleted:     rev.Deleted,
+			Permissions: rev.Permissions,
+		}
+
+		if i > 0 {
+			revision.Added = difference(rev.Permissions, revisions[i-1].Permissions)
+			revision.Removed = difference(revisions[i-1].Permissions, rev.Permissions)
+		}
+
+		history = append(history, revision)
+	}
+
+	return history, nil
+}
+
+// difference returns the permissions in a which are not in b.
+func difference(a, b []string) []string {
+	exclude := make(map[string]bool, len(b))
+	for _, p := range b {
+		exclude[p] = true
+	}
+
+	var diff []string
+	for _, p := range a {
+		if !exclude[p] {
+			diff = append(diff, p)
+		}
+	}
+
+	return diff
+}
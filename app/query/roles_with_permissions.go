@@ -4,13 +4,40 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sort"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/rosstimothy/iam/app/apperr"
 	"github.com/rosstimothy/iam/ent"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/role"
 )
 
 type RolesWithPermissions struct {
+	// Permissions are the names of the permissions, or glob patterns
+	// matching them such as storage.objects.*.
 	Permissions []string
+	// Match determines whether roles must grant all or any of the
+	// permissions, MatchAll if not set.
+	Match Match
+	// Scope optionally limits the roles to those of the given scope,
+	// i.e. predefined, organization or project.
+	Scope string
+	// Parent optionally limits the roles to those defined by the given
+	// organizations/<id> or projects/<id>.
+	Parent string
+	// Stages optionally limits the roles to those in the given stages.
+	Stages []string
+	// ExcludeStages optionally leaves out the roles in the given stages.
+	ExcludeStages []string
+	// IncludeDeleted includes the roles which are deleted upstream.
+	IncludeDeleted bool
+	// AsOf optionally finds the roles which granted the permissions at
+	// the given time instead of those which grant them now.
+	AsOf *time.Time
 }
 
 type RolesWithPermissionsHandler struct {
@@ -29,6 +56,7 @@ func (l *RolesWithPermissionsHandler) Handle(ctx context.Context, cmd RolesWithP
 	fmt.Printf("looking for roles with permissions %s\n", cmd.Permissions)
 
 	defer func() {
+		err = wrapError(err)
 		if err != nil {
 			fmt.Printf("failed to find roles with permissions %s\n", cmd.Permissions)
 			return
@@ -37,11 +65,47 @@ func (l *RolesWithPermissionsHandler) Handle(ctx context.Context, cmd RolesWithP
 		fmt.Printf("succesfully found roles with permissions %s\n", cmd.Permissions)
 	}()
 
+	if len(cmd.Permissions) == 0 {
+		return nil, apperr.InvalidArgument("no permissions provided")
+	}
+
+	permissions, _, err := expandPermissions(ctx, l.client, cmd.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	var hasPermissions predicate.Role
+	switch cmd.Match {
+	case MatchAll, "":
+		hasPermissions = hasAllPermissions(permissions...)
+	case MatchAny:
+		hasPermissions = role.HasPermissionsWith(permission.NameIn(permissions...))
+	default:
+		return nil, apperr.InvalidArgument("invalid match %q", cmd.Match)
+	}
+
+	var preds []predicate.Role
+	scopePreds, err := scopePredicates(cmd.Scope, cmd.Parent)
+	if err != nil {
+		return nil, err
+	}
+	preds = append(preds, scopePreds...)
+
+	if cmd.AsOf != nil {
+		return l.rolesAt(ctx, cmd, permissions, preds)
+	}
+
+	stages, err := stagePredicates(cmd.Stages, cmd.ExcludeStages)
+	if err != nil {
+		return nil, err
+	}
+
 	roles, err := l.client.Role.
 		Query().
-		QueryPermissions().
-		Where(permission.NameIn(cmd.Permissions...)).
-		QueryRoles().
+		Where(hasPermissions).
+		Where(stages...).
+		Where(notDeleted(cmd.IncludeDeleted)...).
+		Where(preds...).
 		WithPermissions().
 		All(ctx)
 	if err != nil {
@@ -49,7 +113,7 @@ func (l *RolesWithPermissionsHandler) Handle(ctx context.Context, cmd RolesWithP
 	}
 
 	if len(roles) == 0 {
-		return nil, fmt.Errorf("no roles for permissions %v", &cmd.Permissions)
+		return nil, notFoundError(ctx, l.client, "no roles grant permissions %s", cmd.Permissions)
 	}
 
 	r := make([]Role, len(roles))
@@ -60,8 +124,11 @@ func (l *RolesWithPermissionsHandler) Handle(ctx context.Context, cmd RolesWithP
 			Title:       rr.Title,
 			Description: rr.Description,
 			Permissions: nil,
-			Stage:       rr.Stage,
+			Stage:       rr.Stage.String(),
 			Etag:        hex.EncodeToString(rr.Etag),
+			Scope:       rr.Scope.String(),
+			DeletedAt:   rr.DeletedAt,
+			Parent:      rr.Parent,
 		}
 
 		r[i].Permissions = make([]string, len(rr.Edges.Permissions))
@@ -72,3 +139,75 @@ func (l *RolesWithPermissionsHandler) Handle(ctx context.Context, cmd RolesWithP
 
 	return r, nil
 }
+
+// rolesAt returns the roles which granted the permissions at cmd.AsOf,
+// resolved from the grants valid at that time, as recorded by their latest
+// revisions at that time.
+func (l *RolesWithPermissionsHandler) rolesAt(ctx context.Context, cmd RolesWithPermissions, permissions []string, preds []predicate.Role) ([]Role, error) {
+	asOf := cmd.AsOf.UTC()
+
+	inStages, err := stageFilter(cmd.Stages, cmd.ExcludeStages)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkGrantsAt(ctx, l.client, asOf); err != nil {
+		return nil, err
+	}
+
+	if cmd.Match == MatchAny {
+		preds = append(preds, grantedAnyAt(asOf, permissions...))
+	} else {
+		preds = append(preds, grantedAllAt(asOf, permissions...))
+	}
+
+	revisions, err := revisionsAt(ctx, l.client, asOf, preds...)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []Role
+	for _, rev := range revisions {
+		if rev.Deleted && !cmd.IncludeDeleted || !inStages(rev.Stage.String()) {
+			continue
+		}
+
+		roles = append(roles, revisionRole(rev))
+	}
+
+	if len(roles) == 0 {
+		return nil, notFoundError(ctx, l.client, "no roles granted permissions %s at %s", cmd.Permissions, asOf.Format(time.RFC3339))
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+
+	return roles, nil
+}
+
+// hasAllPermissions matches the roles which grant every one of the named permissions.
+func hasAllPermissions(names ...string) predicate.Role {
+	unique := map[string]bool{}
+	args := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		if !unique[name] {
+			unique[name] = true
+			args = append(args, name)
+		}
+	}
+
+	return predicate.Role(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		edge := builder.Table(role.PermissionsTable)
+		perms := builder.Table(permission.Table)
+		matches := builder.Select(edge.C(role.PermissionsPrimaryKey[0])).
+			From(edge).
+			Join(perms).
+			On(edge.C(role.PermissionsPrimaryKey[1]), perms.C(permission.FieldID)).
+			Where(sql.In(perms.C(permission.FieldName), args...)).
+			GroupBy(edge.C(role.PermissionsPrimaryKey[0])).
+			Having(sql.EQ(sql.Count("*"), len(args)))
+		s.Where(sql.In(s.C(role.FieldID), matches))
+	})
+}
@@ -0,0 +1,26 @@
+package query
+
+import (
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/role"
+)
+
+// scopePredicates limits the roles to those of the given scope and/or
+// defined by the given parent, if set.
+func scopePredicates(scope, parent string) ([]predicate.Role, error) {
+	var preds []predicate.Role
+	if scope != "" {
+		s := role.Scope(scope)
+		if err := role.ScopeValidator(s); err != nil {
+			return nil, apperr.InvalidArgument("invalid scope %q", scope)
+		}
+		preds = append(preds, role.ScopeEQ(s))
+	}
+
+	if parent != "" {
+		preds = append(preds, role.Parent(parent))
+	}
+
+	return preds, nil
+}
@@ -0,0 +1,125 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/role"
+)
+
+// stageOrder is the order of the launch stages as
+// numbered by adminpb.Role_RoleLaunchStage.
+var stageOrder = map[role.Stage]int{
+	role.StageALPHA:      0,
+	role.StageBETA:       1,
+	role.StageGA:         2,
+	role.StageDEPRECATED: 4,
+	role.StageDISABLED:   5,
+	role.StageEAP:        6,
+}
+
+// defaultExcludedStages are left out of recommendations
+// unless stages are requested explicitly.
+var defaultExcludedStages = []string{role.StageDEPRECATED.String(), role.StageALPHA.String()}
+
+// stagePredicates limits roles to those in stages, if any, and not in
+// excludeStages. Stage names are case insensitive.
+func stagePredicates(stages, excludeStages []string) ([]predicate.Role, error) {
+	var preds []predicate.Role
+	if len(stages) > 0 {
+		s, err := parseStages(stages)
+		if err != nil {
+			return nil, err
+		}
+		preds = append(preds, role.StageIn(s...))
+	}
+
+	if len(excludeStages) > 0 {
+		s, err := parseStages(excludeStages)
+		if err != nil {
+			return nil, err
+		}
+		preds = append(preds, role.StageNotIn(s...))
+	}
+
+	return preds, nil
+}
+
+// stageFilter reports whether a stage passes the same filters as stagePredicates.
+func stageFilter(stages, excludeStages []string) (func(stage string) bool, error) {
+	include, err := parseStages(stages)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude, err := parseStages(excludeStages)
+	if err != nil {
+		return nil, err
+	}
+
+	in := func(stages []role.Stage, stage string) bool {
+		for _, s := range stages {
+			if s.String() == stage {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return func(stage string) bool {
+		if len(include) > 0 && !in(include, stage) {
+			return false
+		}
+
+		return !in(exclude, stage)
+	}, nil
+}
+
+// recommendedStages returns the stage filters of a recommendation, which
+// excludes defaultExcludedStages if excludeStages is nil and no stages are
+// requested.
+func recommendedStages(stages, excludeStages []string) ([]predicate.Role, error) {
+	if len(stages) == 0 && excludeStages == nil {
+		excludeStages = defaultExcludedStages
+	}
+
+	return stagePredicates(stages, excludeStages)
+}
+
+func parseStages(names []string) ([]role.Stage, error) {
+	stages := make([]role.Stage, len(names))
+	for i, name := range names {
+		stages[i] = role.Stage(strings.ToUpper(name))
+		if err := role.StageValidator(stages[i]); err != nil {
+			return nil, apperr.InvalidArgument("invalid stage %q", name)
+		}
+	}
+
+	return stages, nil
+}
+
+// orderedStage writes an expression of the position of the stage
+// of the role selected by s in stageOrder.
+func orderedStage(s *sql.Selector) func(b *sql.Builder) {
+	stages := make([]role.Stage, 0, len(stageOrder))
+	for stage := range stageOrder {
+		stages = append(stages, stage)
+	}
+	sort.Slice(stages, func(i, j int) bool {
+		return stageOrder[stages[i]] < stageOrder[stages[j]]
+	})
+
+	return func(b *sql.Builder) {
+		b.WriteString("CASE ").Ident(s.C(role.FieldStage))
+		for _, stage := range stages {
+			b.WriteString(fmt.Sprintf(" WHEN '%s' THEN %d", stage, stageOrder[stage]))
+		}
+		b.WriteString(" END")
+	}
+}
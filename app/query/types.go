@@ -1,10 +1,121 @@
 package query
 
+import "time"
+
+// Match determines how roles are matched against a set of permissions.
+type Match string
+
+const (
+	// MatchAll matches roles which grant every permission.
+	MatchAll Match = "all"
+	// MatchAny matches roles which grant at least one permission.
+	MatchAny Match = "any"
+)
+
 type Role struct {
+	Name        string     `json:"name"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Permissions []string   `json:"permissions"`
+	Stage       string     `json:"stage"`
+	Etag        string     `json:"etag"`
+	Scope       string     `json:"scope"`
+	Parent      string     `json:"parent,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// RoleSummary describes a role without listing its permissions.
+type RoleSummary struct {
+	Name            string     `json:"name"`
+	Title           string     `json:"title"`
+	Stage           string     `json:"stage"`
+	Scope           string     `json:"scope"`
+	PermissionCount int        `json:"permission_count"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+}
+
+type Permission struct {
+	Name      string   `json:"name"`
+	RoleCount int      `json:"role_count"`
+	Roles     []string `json:"roles"`
+}
+
+// PermissionSummary describes a permission without listing its roles.
+type PermissionSummary struct {
+	Name      string `json:"name"`
+	RoleCount int    `json:"role_count"`
+}
+
+// Service is the first segment of permission names such as storage.
+type Service struct {
+	Name              string `json:"name"`
+	ResourceTypeCount int    `json:"resource_type_count"`
+	PermissionCount   int    `json:"permission_count"`
+}
+
+// ResourceType is the segment of permission names between
+// the service and the verb such as objects.
+type ResourceType struct {
+	Service     string   `json:"service"`
 	Name        string   `json:"name"`
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	Permissions []string `json:"permissions"`
-	Stage       int      `json:"stage"`
-	Etag        string   `json:"etag"`
+	Verbs       []string `json:"verbs"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// RoleRevision is a snapshot of a role recorded by the sync
+// whenever the role changed.
+type RoleRevision struct {
+	Revision    int       `json:"revision"`
+	CreatedAt   time.Time `json:"created_at"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Stage       string    `json:"stage"`
+	Etag        string    `json:"etag"`
+	Deleted     bool      `json:"deleted,omitempty"`
+	Permissions []string  `json:"permissions"`
+	// Added and Removed are the permissions which changed
+	// since the previous revision.
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// Change is a change of a role made by a sync.
+type Change struct {
+	// ID orders the changes, later changes have larger ids.
+	ID int `json:"id"`
+	// ChangeSet identifies the sync which made the change.
+	ChangeSet          int       `json:"change_set"`
+	SyncedAt           time.Time `json:"synced_at"`
+	Role               string    `json:"role"`
+	Kind               string    `json:"kind"`
+	Title              string    `json:"title"`
+	PreviousTitle      string    `json:"previous_title,omitempty"`
+	Stage              string    `json:"stage"`
+	PreviousStage      string    `json:"previous_stage,omitempty"`
+	PermissionsAdded   []string  `json:"permissions_added,omitempty"`
+	PermissionsRemoved []string  `json:"permissions_removed,omitempty"`
+}
+
+// Webhook is a subscription to the changes made by the syncs.
+type Webhook struct {
+	ID                 int       `json:"id"`
+	URL                string    `json:"url"`
+	RolePatterns       []string  `json:"role_patterns,omitempty"`
+	PermissionPatterns []string  `json:"permission_patterns,omitempty"`
+	Kinds              []string  `json:"kinds,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// WebhookDelivery is the delivery of the changes of a change set to a webhook.
+type WebhookDelivery struct {
+	ID        int    `json:"id"`
+	ChangeSet int    `json:"change_set"`
+	Status    string `json:"status"`
+	Attempts  int    `json:"attempts"`
+	// ResponseCode and Error describe the outcome of the last attempt.
+	ResponseCode  int        `json:"response_code,omitempty"`
+	Error         string     `json:"error,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
 }
@@ -0,0 +1,211 @@
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/rosstimothy/iam/app/apperr"
+	"github.com/rosstimothy/iam/ent"
+	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/webhook"
+	"github.com/rosstimothy/iam/ent/webhookdelivery"
+)
+
+type ListWebhooks struct{}
+
+type ListWebhooksHandler struct {
+	client *ent.Client
+}
+
+func NewListWebhooksHandler(client *ent.Client) *ListWebhooksHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &ListWebhooksHandler{client: client}
+}
+
+// Handle returns every webhook ordered by id.
+func (l *ListWebhooksHandler) Handle(ctx context.Context, _ ListWebhooks) (_ []Webhook, err error) {
+	fmt.Println("listing webhooks")
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Println("failed to list webhooks")
+			return
+		}
+
+		fmt.Println("succesfully listed webhooks")
+	}()
+
+	webhooks, err := l.client.Webhook.Query().Order(ent.Asc(webhook.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Webhook, len(webhooks))
+	for i, w := range webhooks {
+		result[i] = webhookResult(w)
+	}
+
+	return result, nil
+}
+
+type WebhookByID struct {
+	ID int
+}
+
+type WebhookByIDHandler struct {
+	client *ent.Client
+}
+
+func NewWebhookByIDHandler(client *ent.Client) *WebhookByIDHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &WebhookByIDHandler{client: client}
+}
+
+func (l *WebhookByIDHandler) Handle(ctx context.Context, cmd WebhookByID) (_ *Webhook, err error) {
+	fmt.Printf("looking for webhook %d\n", cmd.ID)
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to find webhook %d\n", cmd.ID)
+			return
+		}
+
+		fmt.Printf("succesfully found webhook %d\n", cmd.ID)
+	}()
+
+	w, err := l.client.Webhook.Get(ctx, cmd.ID)
+	if ent.IsNotFound(err) {
+		return nil, apperr.NotFound("webhook %d not found", cmd.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := webhookResult(w)
+	return &result, nil
+}
+
+type ListWebhookDeliveries struct {
+	Webhook int
+	// PageSize is the maximum number of deliveries returned, 100 if not set.
+	PageSize int
+	// PageToken is the NextPageToken of the previous page.
+	PageToken string
+}
+
+type WebhookDeliveryPage struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	// NextPageToken retrieves the next page, empty on the last page.
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+type ListWebhookDeliveriesHandler struct {
+	client *ent.Client
+}
+
+func NewListWebhookDeliveriesHandler(client *ent.Client) *ListWebhookDeliveriesHandler {
+	if client == nil {
+		panic("nil client")
+	}
+
+	return &ListWebhookDeliveriesHandler{client: client}
+}
+
+// Handle returns a page of the delivery log of a webhook, newest first.
+func (l *ListWebhookDeliveriesHandler) Handle(ctx context.Context, cmd ListWebhookDeliveries) (_ *WebhookDeliveryPage, err error) {
+	fmt.Printf("listing deliveries of webhook %d\n", cmd.Webhook)
+	defer func() {
+		err = wrapError(err)
+		if err != nil {
+			fmt.Printf("failed to list deliveries of webhook %d\n", cmd.Webhook)
+			return
+		}
+
+		fmt.Printf("succesfully listed deliveries of webhook %d\n", cmd.Webhook)
+	}()
+
+	pageSize, err := validPageSize(cmd.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := l.client.Webhook.Query().Where(webhook.ID(cmd.Webhook)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, apperr.NotFound("webhook %d not found", cmd.Webhook)
+	}
+
+	preds := []predicate.WebhookDelivery{webhookdelivery.HasWebhookWith(webhook.ID(cmd.Webhook))}
+	if cmd.PageToken != "" {
+		last, err := base64.RawURLEncoding.DecodeString(cmd.PageToken)
+		if err != nil {
+			return nil, apperr.InvalidArgument("invalid page token")
+		}
+
+		id, err := strconv.Atoi(string(last))
+		if err != nil {
+			return nil, apperr.InvalidArgument("invalid page token")
+		}
+		preds = append(preds, webhookdelivery.IDLT(id))
+	}
+
+	deliveries, err := l.client.WebhookDelivery.Query().
+		Where(preds...).
+		WithChangeSet().
+		Order(ent.Desc(webhookdelivery.FieldID)).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &WebhookDeliveryPage{Deliveries: []WebhookDelivery{}}
+	for i, d := range deliveries {
+		if i == pageSize {
+			page.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(deliveries[i-1].ID)))
+			break
+		}
+
+		delivery := WebhookDelivery{
+			ID:           d.ID,
+			ChangeSet:    d.Edges.ChangeSet.ID,
+			Status:       d.Status.String(),
+			Attempts:     d.Attempts,
+			ResponseCode: d.ResponseCode,
+			Error:        d.Error,
+			CreatedAt:    d.CreatedAt,
+			DeliveredAt:  d.DeliveredAt,
+		}
+
+		if d.Status == webhookdelivery.StatusPending {
+			next := d.NextAttemptAt
+			delivery.NextAttemptAt = &next
+		}
+
+		page.Deliveries = append(page.Deliveries, delivery)
+	}
+
+	return page, nil
+}
+
+func webhookResult(w *ent.Webhook) Webhook {
+	return Webhook{
+		ID:                 w.ID,
+		URL:                w.URL,
+		RolePatterns:       w.RolePatterns,
+		PermissionPatterns: w.PermissionPatterns,
+		Kinds:              w.Kinds,
+		CreatedAt:          w.CreatedAt,
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rosstimothy/iam/app/query"
+)
+
+// cover prints the smallest combinations of roles which grant the permissions.
+func cover(args []string) error {
+	flags := flag.NewFlagSet("cover", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: iam cover [flags] PERMISSION...")
+		flags.PrintDefaults()
+	}
+	db := addDatabaseFlags(flags)
+	stages := flags.String("stages", "", "comma separated launch stages candidate roles are limited to, e.g. GA,BETA")
+	excludeStages := flags.String("exclude-stages", "", "comma separated launch stages candidate roles are not in, DEPRECATED,ALPHA if neither stages flag is set")
+	scope := flags.String("scope", "", "scope candidate roles are limited to, one of predefined, organization or project")
+	parent := flags.String("parent", "", "organizations/<id> or projects/<id> candidate roles are limited to")
+	service := flags.String("service", "", "service whose permissions candidate roles must grant some of, e.g. storage")
+	limit := flags.Int("limit", 1, "maximum number of combinations")
+	excess := flags.Bool("excess", false, "list the excess permissions granted by each combination")
+	timeout := flags.Duration("timeout", time.Minute, "maximum duration of the search")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	cmd := query.MinimalRoleCombination{
+		Permissions:   flags.Args(),
+		Scope:         *scope,
+		Parent:        *parent,
+		Service:       *service,
+		Limit:         *limit,
+		IncludeExcess: *excess,
+	}
+
+	cmd.Stages = splitList(*stages)
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == "exclude-stages" {
+			cmd.ExcludeStages = append([]string{}, splitList(*excludeStages)...)
+		}
+	})
+
+	client, err := openClient(db)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	combinations, err := query.NewMinimalRoleCombinationHandler(client).Handle(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range combinations.Expansions {
+		fmt.Printf("%s matches %s\n", e.Pattern, strings.Join(e.Permissions, ", "))
+	}
+
+	if len(combinations.Uncovered) > 0 {
+		fmt.Printf("no role grants %s\n", strings.Join(combinations.Uncovered, ", "))
+	}
+
+	if !combinations.Exact {
+		fmt.Println("search was cut short, combinations may not be minimal")
+	}
+
+	for _, c := range combinations.Solutions {
+		fmt.Printf("%s (%d excess permissions)\n", strings.Join(c.Roles, " + "), c.ExcessCount)
+		for _, p := range c.Excess {
+			fmt.Printf("\t%s\n", p)
+		}
+	}
+
+	return nil
+}
@@ -9,8 +9,16 @@ import (
 
 	"github.com/rosstimothy/iam/ent/migrate"
 
+	"github.com/rosstimothy/iam/ent/changeset"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
+	"github.com/rosstimothy/iam/ent/resourcetype"
 	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolechange"
+	"github.com/rosstimothy/iam/ent/rolerevision"
+	"github.com/rosstimothy/iam/ent/service"
+	"github.com/rosstimothy/iam/ent/webhook"
+	"github.com/rosstimothy/iam/ent/webhookdelivery"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -22,10 +30,26 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ChangeSet is the client for interacting with the ChangeSet builders.
+	ChangeSet *ChangeSetClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
+	// PermissionGrant is the client for interacting with the PermissionGrant builders.
+	PermissionGrant *PermissionGrantClient
+	// ResourceType is the client for interacting with the ResourceType builders.
+	ResourceType *ResourceTypeClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// RoleChange is the client for interacting with the RoleChange builders.
+	RoleChange *RoleChangeClient
+	// RoleRevision is the client for interacting with the RoleRevision builders.
+	RoleRevision *RoleRevisionClient
+	// Service is the client for interacting with the Service builders.
+	Service *ServiceClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -39,8 +63,16 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ChangeSet = NewChangeSetClient(c.config)
 	c.Permission = NewPermissionClient(c.config)
+	c.PermissionGrant = NewPermissionGrantClient(c.config)
+	c.ResourceType = NewResourceTypeClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.RoleChange = NewRoleChangeClient(c.config)
+	c.RoleRevision = NewRoleRevisionClient(c.config)
+	c.Service = NewServiceClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -72,10 +104,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Permission: NewPermissionClient(cfg),
-		Role:       NewRoleClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		ChangeSet:       NewChangeSetClient(cfg),
+		Permission:      NewPermissionClient(cfg),
+		PermissionGrant: NewPermissionGrantClient(cfg),
+		ResourceType:    NewResourceTypeClient(cfg),
+		Role:            NewRoleClient(cfg),
+		RoleChange:      NewRoleChangeClient(cfg),
+		RoleRevision:    NewRoleRevisionClient(cfg),
+		Service:         NewServiceClient(cfg),
+		Webhook:         NewWebhookClient(cfg),
+		WebhookDelivery: NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -93,16 +133,24 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		config:     cfg,
-		Permission: NewPermissionClient(cfg),
-		Role:       NewRoleClient(cfg),
+		config:          cfg,
+		ChangeSet:       NewChangeSetClient(cfg),
+		Permission:      NewPermissionClient(cfg),
+		PermissionGrant: NewPermissionGrantClient(cfg),
+		ResourceType:    NewResourceTypeClient(cfg),
+		Role:            NewRoleClient(cfg),
+		RoleChange:      NewRoleChangeClient(cfg),
+		RoleRevision:    NewRoleRevisionClient(cfg),
+		Service:         NewServiceClient(cfg),
+		Webhook:         NewWebhookClient(cfg),
+		WebhookDelivery: NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Permission.
+//		ChangeSet.
 //		Query().
 //		Count(ctx)
 //
@@ -125,8 +173,138 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.ChangeSet.Use(hooks...)
 	c.Permission.Use(hooks...)
+	c.PermissionGrant.Use(hooks...)
+	c.ResourceType.Use(hooks...)
 	c.Role.Use(hooks...)
+	c.RoleChange.Use(hooks...)
+	c.RoleRevision.Use(hooks...)
+	c.Service.Use(hooks...)
+	c.Webhook.Use(hooks...)
+	c.WebhookDelivery.Use(hooks...)
+}
+
+// ChangeSetClient is a client for the ChangeSet schema.
+type ChangeSetClient struct {
+	config
+}
+
+// NewChangeSetClient returns a client for the ChangeSet from the given config.
+func NewChangeSetClient(c config) *ChangeSetClient {
+	return &ChangeSetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `changeset.Hooks(f(g(h())))`.
+func (c *ChangeSetClient) Use(hooks ...Hook) {
+	c.hooks.ChangeSet = append(c.hooks.ChangeSet, hooks...)
+}
+
+// Create returns a create builder for ChangeSet.
+func (c *ChangeSetClient) Create() *ChangeSetCreate {
+	mutation := newChangeSetMutation(c.config, OpCreate)
+	return &ChangeSetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChangeSet entities.
+func (c *ChangeSetClient) CreateBulk(builders ...*ChangeSetCreate) *ChangeSetCreateBulk {
+	return &ChangeSetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChangeSet.
+func (c *ChangeSetClient) Update() *ChangeSetUpdate {
+	mutation := newChangeSetMutation(c.config, OpUpdate)
+	return &ChangeSetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChangeSetClient) UpdateOne(cs *ChangeSet) *ChangeSetUpdateOne {
+	mutation := newChangeSetMutation(c.config, OpUpdateOne, withChangeSet(cs))
+	return &ChangeSetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChangeSetClient) UpdateOneID(id int) *ChangeSetUpdateOne {
+	mutation := newChangeSetMutation(c.config, OpUpdateOne, withChangeSetID(id))
+	return &ChangeSetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChangeSet.
+func (c *ChangeSetClient) Delete() *ChangeSetDelete {
+	mutation := newChangeSetMutation(c.config, OpDelete)
+	return &ChangeSetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ChangeSetClient) DeleteOne(cs *ChangeSet) *ChangeSetDeleteOne {
+	return c.DeleteOneID(cs.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ChangeSetClient) DeleteOneID(id int) *ChangeSetDeleteOne {
+	builder := c.Delete().Where(changeset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChangeSetDeleteOne{builder}
+}
+
+// Query returns a query builder for ChangeSet.
+func (c *ChangeSetClient) Query() *ChangeSetQuery {
+	return &ChangeSetQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ChangeSet entity by its id.
+func (c *ChangeSetClient) Get(ctx context.Context, id int) (*ChangeSet, error) {
+	return c.Query().Where(changeset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChangeSetClient) GetX(ctx context.Context, id int) *ChangeSet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChanges queries the changes edge of a ChangeSet.
+func (c *ChangeSetClient) QueryChanges(cs *ChangeSet) *RoleChangeQuery {
+	query := &RoleChangeQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(changeset.Table, changeset.FieldID, id),
+			sqlgraph.To(rolechange.Table, rolechange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, changeset.ChangesTable, changeset.ChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveries queries the deliveries edge of a ChangeSet.
+func (c *ChangeSetClient) QueryDeliveries(cs *ChangeSet) *WebhookDeliveryQuery {
+	query := &WebhookDeliveryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(changeset.Table, changeset.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, changeset.DeliveriesTable, changeset.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChangeSetClient) Hooks() []Hook {
+	return c.hooks.ChangeSet
 }
 
 // PermissionClient is a client for the Permission schema.
@@ -230,11 +408,303 @@ func (c *PermissionClient) QueryRoles(pe *Permission) *RoleQuery {
 	return query
 }
 
+// QueryGrants queries the grants edge of a Permission.
+func (c *PermissionClient) QueryGrants(pe *Permission) *PermissionGrantQuery {
+	query := &PermissionGrantQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permission.Table, permission.FieldID, id),
+			sqlgraph.To(permissiongrant.Table, permissiongrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, permission.GrantsTable, permission.GrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryService queries the service edge of a Permission.
+func (c *PermissionClient) QueryService(pe *Permission) *ServiceQuery {
+	query := &ServiceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permission.Table, permission.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, permission.ServiceTable, permission.ServiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceType queries the resource_type edge of a Permission.
+func (c *PermissionClient) QueryResourceType(pe *Permission) *ResourceTypeQuery {
+	query := &ResourceTypeQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permission.Table, permission.FieldID, id),
+			sqlgraph.To(resourcetype.Table, resourcetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, permission.ResourceTypeTable, permission.ResourceTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PermissionClient) Hooks() []Hook {
 	return c.hooks.Permission
 }
 
+// PermissionGrantClient is a client for the PermissionGrant schema.
+type PermissionGrantClient struct {
+	config
+}
+
+// NewPermissionGrantClient returns a client for the PermissionGrant from the given config.
+func NewPermissionGrantClient(c config) *PermissionGrantClient {
+	return &PermissionGrantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `permissiongrant.Hooks(f(g(h())))`.
+func (c *PermissionGrantClient) Use(hooks ...Hook) {
+	c.hooks.PermissionGrant = append(c.hooks.PermissionGrant, hooks...)
+}
+
+// Create returns a create builder for PermissionGrant.
+func (c *PermissionGrantClient) Create() *PermissionGrantCreate {
+	mutation := newPermissionGrantMutation(c.config, OpCreate)
+	return &PermissionGrantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PermissionGrant entities.
+func (c *PermissionGrantClient) CreateBulk(builders ...*PermissionGrantCreate) *PermissionGrantCreateBulk {
+	return &PermissionGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PermissionGrant.
+func (c *PermissionGrantClient) Update() *PermissionGrantUpdate {
+	mutation := newPermissionGrantMutation(c.config, OpUpdate)
+	return &PermissionGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PermissionGrantClient) UpdateOne(pg *PermissionGrant) *PermissionGrantUpdateOne {
+	mutation := newPermissionGrantMutation(c.config, OpUpdateOne, withPermissionGrant(pg))
+	return &PermissionGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PermissionGrantClient) UpdateOneID(id int) *PermissionGrantUpdateOne {
+	mutation := newPermissionGrantMutation(c.config, OpUpdateOne, withPermissionGrantID(id))
+	return &PermissionGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PermissionGrant.
+func (c *PermissionGrantClient) Delete() *PermissionGrantDelete {
+	mutation := newPermissionGrantMutation(c.config, OpDelete)
+	return &PermissionGrantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *PermissionGrantClient) DeleteOne(pg *PermissionGrant) *PermissionGrantDeleteOne {
+	return c.DeleteOneID(pg.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *PermissionGrantClient) DeleteOneID(id int) *PermissionGrantDeleteOne {
+	builder := c.Delete().Where(permissiongrant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PermissionGrantDeleteOne{builder}
+}
+
+// Query returns a query builder for PermissionGrant.
+func (c *PermissionGrantClient) Query() *PermissionGrantQuery {
+	return &PermissionGrantQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a PermissionGrant entity by its id.
+func (c *PermissionGrantClient) Get(ctx context.Context, id int) (*PermissionGrant, error) {
+	return c.Query().Where(permissiongrant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PermissionGrantClient) GetX(ctx context.Context, id int) *PermissionGrant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRole queries the role edge of a PermissionGrant.
+func (c *PermissionGrantClient) QueryRole(pg *PermissionGrant) *RoleQuery {
+	query := &RoleQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permissiongrant.Table, permissiongrant.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, permissiongrant.RoleTable, permissiongrant.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(pg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermission queries the permission edge of a PermissionGrant.
+func (c *PermissionGrantClient) QueryPermission(pg *PermissionGrant) *PermissionQuery {
+	query := &PermissionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permissiongrant.Table, permissiongrant.FieldID, id),
+			sqlgraph.To(permission.Table, permission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, permissiongrant.PermissionTable, permissiongrant.PermissionColumn),
+		)
+		fromV = sqlgraph.Neighbors(pg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PermissionGrantClient) Hooks() []Hook {
+	return c.hooks.PermissionGrant
+}
+
+// ResourceTypeClient is a client for the ResourceType schema.
+type ResourceTypeClient struct {
+	config
+}
+
+// NewResourceTypeClient returns a client for the ResourceType from the given config.
+func NewResourceTypeClient(c config) *ResourceTypeClient {
+	return &ResourceTypeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resourcetype.Hooks(f(g(h())))`.
+func (c *ResourceTypeClient) Use(hooks ...Hook) {
+	c.hooks.ResourceType = append(c.hooks.ResourceType, hooks...)
+}
+
+// Create returns a create builder for ResourceType.
+func (c *ResourceTypeClient) Create() *ResourceTypeCreate {
+	mutation := newResourceTypeMutation(c.config, OpCreate)
+	return &ResourceTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResourceType entities.
+func (c *ResourceTypeClient) CreateBulk(builders ...*ResourceTypeCreate) *ResourceTypeCreateBulk {
+	return &ResourceTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResourceType.
+func (c *ResourceTypeClient) Update() *ResourceTypeUpdate {
+	mutation := newResourceTypeMutation(c.config, OpUpdate)
+	return &ResourceTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResourceTypeClient) UpdateOne(rt *ResourceType) *ResourceTypeUpdateOne {
+	mutation := newResourceTypeMutation(c.config, OpUpdateOne, withResourceType(rt))
+	return &ResourceTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResourceTypeClient) UpdateOneID(id int) *ResourceTypeUpdateOne {
+	mutation := newResourceTypeMutation(c.config, OpUpdateOne, withResourceTypeID(id))
+	return &ResourceTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResourceType.
+func (c *ResourceTypeClient) Delete() *ResourceTypeDelete {
+	mutation := newResourceTypeMutation(c.config, OpDelete)
+	return &ResourceTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ResourceTypeClient) DeleteOne(rt *ResourceType) *ResourceTypeDeleteOne {
+	return c.DeleteOneID(rt.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ResourceTypeClient) DeleteOneID(id int) *ResourceTypeDeleteOne {
+	builder := c.Delete().Where(resourcetype.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResourceTypeDeleteOne{builder}
+}
+
+// Query returns a query builder for ResourceType.
+func (c *ResourceTypeClient) Query() *ResourceTypeQuery {
+	return &ResourceTypeQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ResourceType entity by its id.
+func (c *ResourceTypeClient) Get(ctx context.Context, id int) (*ResourceType, error) {
+	return c.Query().Where(resourcetype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResourceTypeClient) GetX(ctx context.Context, id int) *ResourceType {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryService queries the service edge of a ResourceType.
+func (c *ResourceTypeClient) QueryService(rt *ResourceType) *ServiceQuery {
+	query := &ServiceQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcetype.Table, resourcetype.FieldID, id),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcetype.ServiceTable, resourcetype.ServiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(rt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermissions queries the permissions edge of a ResourceType.
+func (c *ResourceTypeClient) QueryPermissions(rt *ResourceType) *PermissionQuery {
+	query := &PermissionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcetype.Table, resourcetype.FieldID, id),
+			sqlgraph.To(permission.Table, permission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resourcetype.PermissionsTable, resourcetype.PermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(rt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResourceTypeClient) Hooks() []Hook {
+	return c.hooks.ResourceType
+}
+
 // RoleClient is a client for the Role schema.
 type RoleClient struct {
 	config
@@ -336,7 +806,633 @@ func (c *RoleClient) QueryPermissions(r *Role) *PermissionQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *RoleClient) Hooks() []Hook {
-	return c.hooks.Role
+// QuerySupersets queries the supersets edge of a Role.
+func (c *RoleClient) QuerySupersets(r *Role) *RoleQuery {
+	query := &RoleQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, role.SupersetsTable, role.SupersetsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubsets queries the subsets edge of a Role.
+func (c *RoleClient) QuerySubsets(r *Role) *RoleQuery {
+	query := &RoleQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, role.SubsetsTable, role.SubsetsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Role.
+func (c *RoleClient) QueryRevisions(r *Role) *RoleRevisionQuery {
+	query := &RoleRevisionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(rolerevision.Table, rolerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.RevisionsTable, role.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGrants queries the grants edge of a Role.
+func (c *RoleClient) QueryGrants(r *Role) *PermissionGrantQuery {
+	query := &PermissionGrantQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(permissiongrant.Table, permissiongrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.GrantsTable, role.GrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
+}
+
+// RoleChangeClient is a client for the RoleChange schema.
+type RoleChangeClient struct {
+	config
+}
+
+// NewRoleChangeClient returns a client for the RoleChange from the given config.
+func NewRoleChangeClient(c config) *RoleChangeClient {
+	return &RoleChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolechange.Hooks(f(g(h())))`.
+func (c *RoleChangeClient) Use(hooks ...Hook) {
+	c.hooks.RoleChange = append(c.hooks.RoleChange, hooks...)
+}
+
+// Create returns a create builder for RoleChange.
+func (c *RoleChangeClient) Create() *RoleChangeCreate {
+	mutation := newRoleChangeMutation(c.config, OpCreate)
+	return &RoleChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleChange entities.
+func (c *RoleChangeClient) CreateBulk(builders ...*RoleChangeCreate) *RoleChangeCreateBulk {
+	return &RoleChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleChange.
+func (c *RoleChangeClient) Update() *RoleChangeUpdate {
+	mutation := newRoleChangeMutation(c.config, OpUpdate)
+	return &RoleChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleChangeClient) UpdateOne(rc *RoleChange) *RoleChangeUpdateOne {
+	mutation := newRoleChangeMutation(c.config, OpUpdateOne, withRoleChange(rc))
+	return &RoleChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleChangeClient) UpdateOneID(id int) *RoleChangeUpdateOne {
+	mutation := newRoleChangeMutation(c.config, OpUpdateOne, withRoleChangeID(id))
+	return &RoleChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleChange.
+func (c *RoleChangeClient) Delete() *RoleChangeDelete {
+	mutation := newRoleChangeMutation(c.config, OpDelete)
+	return &RoleChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *RoleChangeClient) DeleteOne(rc *RoleChange) *RoleChangeDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *RoleChangeClient) DeleteOneID(id int) *RoleChangeDeleteOne {
+	builder := c.Delete().Where(rolechange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleChange.
+func (c *RoleChangeClient) Query() *RoleChangeQuery {
+	return &RoleChangeQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a RoleChange entity by its id.
+func (c *RoleChangeClient) Get(ctx context.Context, id int) (*RoleChange, error) {
+	return c.Query().Where(rolechange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleChangeClient) GetX(ctx context.Context, id int) *RoleChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChangeSet queries the change_set edge of a RoleChange.
+func (c *RoleChangeClient) QueryChangeSet(rc *RoleChange) *ChangeSetQuery {
+	query := &ChangeSetQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolechange.Table, rolechange.FieldID, id),
+			sqlgraph.To(changeset.Table, changeset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolechange.ChangeSetTable, rolechange.ChangeSetColumn),
+		)
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleChangeClient) Hooks() []Hook {
+	return c.hooks.RoleChange
+}
+
+// RoleRevisionClient is a client for the RoleRevision schema.
+type RoleRevisionClient struct {
+	config
+}
+
+// NewRoleRevisionClient returns a client for the RoleRevision from the given config.
+func NewRoleRevisionClient(c config) *RoleRevisionClient {
+	return &RoleRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolerevision.Hooks(f(g(h())))`.
+func (c *RoleRevisionClient) Use(hooks ...Hook) {
+	c.hooks.RoleRevision = append(c.hooks.RoleRevision, hooks...)
+}
+
+// Create returns a create builder for RoleRevision.
+func (c *RoleRevisionClient) Create() *RoleRevisionCreate {
+	mutation := newRoleRevisionMutation(c.config, OpCreate)
+	return &RoleRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleRevision entities.
+func (c *RoleRevisionClient) CreateBulk(builders ...*RoleRevisionCreate) *RoleRevisionCreateBulk {
+	return &RoleRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleRevision.
+func (c *RoleRevisionClient) Update() *RoleRevisionUpdate {
+	mutation := newRoleRevisionMutation(c.config, OpUpdate)
+	return &RoleRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleRevisionClient) UpdateOne(rr *RoleRevision) *RoleRevisionUpdateOne {
+	mutation := newRoleRevisionMutation(c.config, OpUpdateOne, withRoleRevision(rr))
+	return &RoleRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleRevisionClient) UpdateOneID(id int) *RoleRevisionUpdateOne {
+	mutation := newRoleRevisionMutation(c.config, OpUpdateOne, withRoleRevisionID(id))
+	return &RoleRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleRevision.
+func (c *RoleRevisionClient) Delete() *RoleRevisionDelete {
+	mutation := newRoleRevisionMutation(c.config, OpDelete)
+	return &RoleRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *RoleRevisionClient) DeleteOne(rr *RoleRevision) *RoleRevisionDeleteOne {
+	return c.DeleteOneID(rr.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *RoleRevisionClient) DeleteOneID(id int) *RoleRevisionDeleteOne {
+	builder := c.Delete().Where(rolerevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleRevision.
+func (c *RoleRevisionClient) Query() *RoleRevisionQuery {
+	return &RoleRevisionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a RoleRevision entity by its id.
+func (c *RoleRevisionClient) Get(ctx context.Context, id int) (*RoleRevision, error) {
+	return c.Query().Where(rolerevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleRevisionClient) GetX(ctx context.Context, id int) *RoleRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRole queries the role edge of a RoleRevision.
+func (c *RoleRevisionClient) QueryRole(rr *RoleRevision) *RoleQuery {
+	query := &RoleQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolerevision.Table, rolerevision.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolerevision.RoleTable, rolerevision.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleRevisionClient) Hooks() []Hook {
+	return c.hooks.RoleRevision
+}
+
+// ServiceClient is a client for the Service schema.
+type ServiceClient struct {
+	config
+}
+
+// NewServiceClient returns a client for the Service from the given config.
+func NewServiceClient(c config) *ServiceClient {
+	return &ServiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `service.Hooks(f(g(h())))`.
+func (c *ServiceClient) Use(hooks ...Hook) {
+	c.hooks.Service = append(c.hooks.Service, hooks...)
+}
+
+// Create returns a create builder for Service.
+func (c *ServiceClient) Create() *ServiceCreate {
+	mutation := newServiceMutation(c.config, OpCreate)
+	return &ServiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Service entities.
+func (c *ServiceClient) CreateBulk(builders ...*ServiceCreate) *ServiceCreateBulk {
+	return &ServiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Service.
+func (c *ServiceClient) Update() *ServiceUpdate {
+	mutation := newServiceMutation(c.config, OpUpdate)
+	return &ServiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ServiceClient) UpdateOne(s *Service) *ServiceUpdateOne {
+	mutation := newServiceMutation(c.config, OpUpdateOne, withService(s))
+	return &ServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ServiceClient) UpdateOneID(id int) *ServiceUpdateOne {
+	mutation := newServiceMutation(c.config, OpUpdateOne, withServiceID(id))
+	return &ServiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Service.
+func (c *ServiceClient) Delete() *ServiceDelete {
+	mutation := newServiceMutation(c.config, OpDelete)
+	return &ServiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ServiceClient) DeleteOne(s *Service) *ServiceDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ServiceClient) DeleteOneID(id int) *ServiceDeleteOne {
+	builder := c.Delete().Where(service.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ServiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Service.
+func (c *ServiceClient) Query() *ServiceQuery {
+	return &ServiceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Service entity by its id.
+func (c *ServiceClient) Get(ctx context.Context, id int) (*Service, error) {
+	return c.Query().Where(service.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ServiceClient) GetX(ctx context.Context, id int) *Service {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryResourceTypes queries the resource_types edge of a Service.
+func (c *ServiceClient) QueryResourceTypes(s *Service) *ResourceTypeQuery {
+	query := &ResourceTypeQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, id),
+			sqlgraph.To(resourcetype.Table, resourcetype.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.ResourceTypesTable, service.ResourceTypesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermissions queries the permissions edge of a Service.
+func (c *ServiceClient) QueryPermissions(s *Service) *PermissionQuery {
+	query := &PermissionQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(service.Table, service.FieldID, id),
+			sqlgraph.To(permission.Table, permission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, service.PermissionsTable, service.PermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ServiceClient) Hooks() []Hook {
+	return c.hooks.Service
+}
+
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Create returns a create builder for Webhook.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(w *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(w))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id int) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WebhookClient) DeleteOne(w *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WebhookClient) DeleteOneID(id int) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id int) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id int) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(w *Webhook) *WebhookDeliveryQuery {
+	query := &WebhookDeliveryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	return c.hooks.Webhook
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Create returns a create builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id int) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WebhookDeliveryClient) DeleteOneID(id int) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(wd *WebhookDelivery) *WebhookQuery {
+	query := &WebhookQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChangeSet queries the change_set edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryChangeSet(wd *WebhookDelivery) *ChangeSetQuery {
+	query := &ChangeSetQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(changeset.Table, changeset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.ChangeSetTable, webhookdelivery.ChangeSetColumn),
+		)
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
 }
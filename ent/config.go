@@ -24,8 +24,16 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Permission []ent.Hook
-	Role       []ent.Hook
+	ChangeSet       []ent.Hook
+	Permission      []ent.Hook
+	PermissionGrant []ent.Hook
+	ResourceType    []ent.Hook
+	Role            []ent.Hook
+	RoleChange      []ent.Hook
+	RoleRevision    []ent.Hook
+	Service         []ent.Hook
+	Webhook         []ent.Hook
+	WebhookDelivery []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -10,8 +10,16 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/rosstimothy/iam/ent/changeset"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
+	"github.com/rosstimothy/iam/ent/resourcetype"
 	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolechange"
+	"github.com/rosstimothy/iam/ent/rolerevision"
+	"github.com/rosstimothy/iam/ent/service"
+	"github.com/rosstimothy/iam/ent/webhook"
+	"github.com/rosstimothy/iam/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -32,8 +40,16 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		permission.Table: permission.ValidColumn,
-		role.Table:       role.ValidColumn,
+		changeset.Table:       changeset.ValidColumn,
+		permission.Table:      permission.ValidColumn,
+		permissiongrant.Table: permissiongrant.ValidColumn,
+		resourcetype.Table:    resourcetype.ValidColumn,
+		role.Table:            role.ValidColumn,
+		rolechange.Table:      rolechange.ValidColumn,
+		rolerevision.Table:    rolerevision.ValidColumn,
+		service.Table:         service.ValidColumn,
+		webhook.Table:         webhook.ValidColumn,
+		webhookdelivery.Table: webhookdelivery.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
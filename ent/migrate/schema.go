@@ -8,17 +8,119 @@ import (
 )
 
 var (
+	// ChangeSetsColumns holds the columns for the "change_sets" table.
+	ChangeSetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ChangeSetsTable holds the schema information for the "change_sets" table.
+	ChangeSetsTable = &schema.Table{
+		Name:        "change_sets",
+		Columns:     ChangeSetsColumns,
+		PrimaryKey:  []*schema.Column{ChangeSetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "changeset_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChangeSetsColumns[1]},
+			},
+		},
+	}
 	// PermissionsColumns holds the columns for the "permissions" table.
 	PermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "verb", Type: field.TypeString, Default: ""},
+		{Name: "resource_type_permissions", Type: field.TypeInt, Nullable: true},
+		{Name: "service_permissions", Type: field.TypeInt, Nullable: true},
 	}
 	// PermissionsTable holds the schema information for the "permissions" table.
 	PermissionsTable = &schema.Table{
-		Name:        "permissions",
-		Columns:     PermissionsColumns,
-		PrimaryKey:  []*schema.Column{PermissionsColumns[0]},
-		ForeignKeys: []*schema.ForeignKey{},
+		Name:       "permissions",
+		Columns:    PermissionsColumns,
+		PrimaryKey: []*schema.Column{PermissionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "permissions_resource_types_permissions",
+				Columns:    []*schema.Column{PermissionsColumns[3]},
+				RefColumns: []*schema.Column{ResourceTypesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "permissions_services_permissions",
+				Columns:    []*schema.Column{PermissionsColumns[4]},
+				RefColumns: []*schema.Column{ServicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PermissionGrantsColumns holds the columns for the "permission_grants" table.
+	PermissionGrantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "permission_grants", Type: field.TypeInt, Nullable: true},
+		{Name: "role_grants", Type: field.TypeInt, Nullable: true},
+	}
+	// PermissionGrantsTable holds the schema information for the "permission_grants" table.
+	PermissionGrantsTable = &schema.Table{
+		Name:       "permission_grants",
+		Columns:    PermissionGrantsColumns,
+		PrimaryKey: []*schema.Column{PermissionGrantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "permission_grants_permissions_grants",
+				Columns:    []*schema.Column{PermissionGrantsColumns[3]},
+				RefColumns: []*schema.Column{PermissionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "permission_grants_roles_grants",
+				Columns:    []*schema.Column{PermissionGrantsColumns[4]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "permissiongrant_valid_from_role_grants",
+				Unique:  false,
+				Columns: []*schema.Column{PermissionGrantsColumns[1], PermissionGrantsColumns[4]},
+			},
+			{
+				Name:    "permissiongrant_valid_from_permission_grants",
+				Unique:  false,
+				Columns: []*schema.Column{PermissionGrantsColumns[1], PermissionGrantsColumns[3]},
+			},
+		},
+	}
+	// ResourceTypesColumns holds the columns for the "resource_types" table.
+	ResourceTypesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "service_resource_types", Type: field.TypeInt, Nullable: true},
+	}
+	// ResourceTypesTable holds the schema information for the "resource_types" table.
+	ResourceTypesTable = &schema.Table{
+		Name:       "resource_types",
+		Columns:    ResourceTypesColumns,
+		PrimaryKey: []*schema.Column{ResourceTypesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "resource_types_services_resource_types",
+				Columns:    []*schema.Column{ResourceTypesColumns[2]},
+				RefColumns: []*schema.Column{ServicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resourcetype_name_service_resource_types",
+				Unique:  true,
+				Columns: []*schema.Column{ResourceTypesColumns[1], ResourceTypesColumns[2]},
+			},
+		},
 	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
@@ -26,8 +128,11 @@ var (
 		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString},
-		{Name: "stage", Type: field.TypeInt},
+		{Name: "stage", Type: field.TypeEnum, Enums: []string{"ALPHA", "BETA", "GA", "DEPRECATED", "DISABLED", "EAP"}},
 		{Name: "etag", Type: field.TypeBytes},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"predefined", "organization", "project"}, Default: "predefined"},
+		{Name: "parent", Type: field.TypeString, Default: ""},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// RolesTable holds the schema information for the "roles" table.
 	RolesTable = &schema.Table{
@@ -36,6 +141,136 @@ var (
 		PrimaryKey:  []*schema.Column{RolesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{},
 	}
+	// RoleChangesColumns holds the columns for the "role_changes" table.
+	RoleChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"added", "deleted", "undeleted", "updated"}},
+		{Name: "title", Type: field.TypeString},
+		{Name: "previous_title", Type: field.TypeString, Nullable: true},
+		{Name: "stage", Type: field.TypeEnum, Enums: []string{"ALPHA", "BETA", "GA", "DEPRECATED", "DISABLED", "EAP"}},
+		{Name: "previous_stage", Type: field.TypeEnum, Nullable: true, Enums: []string{"ALPHA", "BETA", "GA", "DEPRECATED", "DISABLED", "EAP"}},
+		{Name: "permissions_added", Type: field.TypeJSON, Nullable: true},
+		{Name: "permissions_removed", Type: field.TypeJSON, Nullable: true},
+		{Name: "change_set_changes", Type: field.TypeInt, Nullable: true},
+	}
+	// RoleChangesTable holds the schema information for the "role_changes" table.
+	RoleChangesTable = &schema.Table{
+		Name:       "role_changes",
+		Columns:    RoleChangesColumns,
+		PrimaryKey: []*schema.Column{RoleChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_changes_change_sets_changes",
+				Columns:    []*schema.Column{RoleChangesColumns[9]},
+				RefColumns: []*schema.Column{ChangeSetsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// RoleRevisionsColumns holds the columns for the "role_revisions" table.
+	RoleRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString},
+		{Name: "stage", Type: field.TypeEnum, Enums: []string{"ALPHA", "BETA", "GA", "DEPRECATED", "DISABLED", "EAP"}},
+		{Name: "etag", Type: field.TypeBytes},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "deleted", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "role_revisions", Type: field.TypeInt, Nullable: true},
+	}
+	// RoleRevisionsTable holds the schema information for the "role_revisions" table.
+	RoleRevisionsTable = &schema.Table{
+		Name:       "role_revisions",
+		Columns:    RoleRevisionsColumns,
+		PrimaryKey: []*schema.Column{RoleRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_revisions_roles_revisions",
+				Columns:    []*schema.Column{RoleRevisionsColumns[9]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolerevision_revision_role_revisions",
+				Unique:  true,
+				Columns: []*schema.Column{RoleRevisionsColumns[1], RoleRevisionsColumns[9]},
+			},
+		},
+	}
+	// ServicesColumns holds the columns for the "services" table.
+	ServicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+	}
+	// ServicesTable holds the schema information for the "services" table.
+	ServicesTable = &schema.Table{
+		Name:        "services",
+		Columns:     ServicesColumns,
+		PrimaryKey:  []*schema.Column{ServicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "role_patterns", Type: field.TypeJSON, Nullable: true},
+		{Name: "permission_patterns", Type: field.TypeJSON, Nullable: true},
+		{Name: "kinds", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:        "webhooks",
+		Columns:     WebhooksColumns,
+		PrimaryKey:  []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "response_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "change_set_deliveries", Type: field.TypeInt, Nullable: true},
+		{Name: "webhook_deliveries", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_change_sets_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[8]},
+				RefColumns: []*schema.Column{ChangeSetsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[9]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[3]},
+			},
+		},
+	}
 	// RolePermissionsColumns holds the columns for the "role_permissions" table.
 	RolePermissionsColumns = []*schema.Column{
 		{Name: "role_id", Type: field.TypeInt},
@@ -61,15 +296,60 @@ var (
 			},
 		},
 	}
+	// RoleSubsetsColumns holds the columns for the "role_subsets" table.
+	RoleSubsetsColumns = []*schema.Column{
+		{Name: "role_id", Type: field.TypeInt},
+		{Name: "superset_id", Type: field.TypeInt},
+	}
+	// RoleSubsetsTable holds the schema information for the "role_subsets" table.
+	RoleSubsetsTable = &schema.Table{
+		Name:       "role_subsets",
+		Columns:    RoleSubsetsColumns,
+		PrimaryKey: []*schema.Column{RoleSubsetsColumns[0], RoleSubsetsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_subsets_role_id",
+				Columns:    []*schema.Column{RoleSubsetsColumns[0]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_subsets_superset_id",
+				Columns:    []*schema.Column{RoleSubsetsColumns[1]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ChangeSetsTable,
 		PermissionsTable,
+		PermissionGrantsTable,
+		ResourceTypesTable,
 		RolesTable,
+		RoleChangesTable,
+		RoleRevisionsTable,
+		ServicesTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 		RolePermissionsTable,
+		RoleSubsetsTable,
 	}
 )
 
 func init() {
+	PermissionsTable.ForeignKeys[0].RefTable = ResourceTypesTable
+	PermissionsTable.ForeignKeys[1].RefTable = ServicesTable
+	PermissionGrantsTable.ForeignKeys[0].RefTable = PermissionsTable
+	PermissionGrantsTable.ForeignKeys[1].RefTable = RolesTable
+	ResourceTypesTable.ForeignKeys[0].RefTable = ServicesTable
+	RoleChangesTable.ForeignKeys[0].RefTable = ChangeSetsTable
+	RoleRevisionsTable.ForeignKeys[0].RefTable = RolesTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = ChangeSetsTable
+	WebhookDeliveriesTable.ForeignKeys[1].RefTable = WebhooksTable
 	RolePermissionsTable.ForeignKeys[0].RefTable = RolesTable
 	RolePermissionsTable.ForeignKeys[1].RefTable = PermissionsTable
+	RoleSubsetsTable.ForeignKeys[0].RefTable = RolesTable
+	RoleSubsetsTable.ForeignKeys[1].RefTable = RolesTable
 }
@@ -8,6 +8,8 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/resourcetype"
+	"github.com/rosstimothy/iam/ent/service"
 )
 
 // Permission is the model entity for the Permission schema.
@@ -17,18 +19,28 @@ type Permission struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Verb holds the value of the "verb" field.
+	Verb string `json:"verb,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PermissionQuery when eager-loading is set.
-	Edges PermissionEdges `json:"edges"`
+	Edges                     PermissionEdges `json:"edges"`
+	resource_type_permissions *int
+	service_permissions       *int
 }
 
 // PermissionEdges holds the relations/edges for other nodes in the graph.
 type PermissionEdges struct {
 	// Roles holds the value of the roles edge.
 	Roles []*Role `json:"roles,omitempty"`
+	// Grants holds the value of the grants edge.
+	Grants []*PermissionGrant `json:"grants,omitempty"`
+	// Service holds the value of the service edge.
+	Service *Service `json:"service,omitempty"`
+	// ResourceType holds the value of the resource_type edge.
+	ResourceType *ResourceType `json:"resource_type,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // RolesOrErr returns the Roles value or an error if the edge
@@ -40,6 +52,43 @@ func (e PermissionEdges) RolesOrErr() ([]*Role, error) {
 	return nil, &NotLoadedError{edge: "roles"}
 }
 
+// GrantsOrErr returns the Grants value or an error if the edge
+// was not loaded in eager-loading.
+func (e PermissionEdges) GrantsOrErr() ([]*PermissionGrant, error) {
+	if e.loadedTypes[1] {
+		return e.Grants, nil
+	}
+	return nil, &NotLoadedError{edge: "grants"}
+}
+
+// ServiceOrErr returns the Service value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PermissionEdges) ServiceOrErr() (*Service, error) {
+	if e.loadedTypes[2] {
+		if e.Service == nil {
+			// The edge service was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: service.Label}
+		}
+		return e.Service, nil
+	}
+	return nil, &NotLoadedError{edge: "service"}
+}
+
+// ResourceTypeOrErr returns the ResourceType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PermissionEdges) ResourceTypeOrErr() (*ResourceType, error) {
+	if e.loadedTypes[3] {
+		if e.ResourceType == nil {
+			// The edge resource_type was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: resourcetype.Label}
+		}
+		return e.ResourceType, nil
+	}
+	return nil, &NotLoadedError{edge: "resource_type"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Permission) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -47,8 +96,12 @@ func (*Permission) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case permission.FieldID:
 			values[i] = new(sql.NullInt64)
-		case permission.FieldName:
+		case permission.FieldName, permission.FieldVerb:
 			values[i] = new(sql.NullString)
+		case permission.ForeignKeys[0]: // resource_type_permissions
+			values[i] = new(sql.NullInt64)
+		case permission.ForeignKeys[1]: // service_permissions
+			values[i] = new(sql.NullInt64)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Permission", columns[i])
 		}
@@ -76,6 +129,26 @@ func (pe *Permission) assignValues(columns []string, values []interface{}) error
 			} else if value.Valid {
 				pe.Name = value.String
 			}
+		case permission.FieldVerb:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verb", values[i])
+			} else if value.Valid {
+				pe.Verb = value.String
+			}
+		case permission.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field resource_type_permissions", value)
+			} else if value.Valid {
+				pe.resource_type_permissions = new(int)
+				*pe.resource_type_permissions = int(value.Int64)
+			}
+		case permission.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field service_permissions", value)
+			} else if value.Valid {
+				pe.service_permissions = new(int)
+				*pe.service_permissions = int(value.Int64)
+			}
 		}
 	}
 	return nil
@@ -86,6 +159,21 @@ func (pe *Permission) QueryRoles() *RoleQuery {
 	return (&PermissionClient{config: pe.config}).QueryRoles(pe)
 }
 
+// QueryGrants queries the "grants" edge of the Permission entity.
+func (pe *Permission) QueryGrants() *PermissionGrantQuery {
+	return (&PermissionClient{config: pe.config}).QueryGrants(pe)
+}
+
+// QueryService queries the "service" edge of the Permission entity.
+func (pe *Permission) QueryService() *ServiceQuery {
+	return (&PermissionClient{config: pe.config}).QueryService(pe)
+}
+
+// QueryResourceType queries the "resource_type" edge of the Permission entity.
+func (pe *Permission) QueryResourceType() *ResourceTypeQuery {
+	return (&PermissionClient{config: pe.config}).QueryResourceType(pe)
+}
+
 // Update returns a builder for updating this Permission.
 // Note that you need to call Permission.Unwrap() before calling this method if this Permission
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -111,6 +199,8 @@ func (pe *Permission) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v", pe.ID))
 	builder.WriteString(", name=")
 	builder.WriteString(pe.Name)
+	builder.WriteString(", verb=")
+	builder.WriteString(pe.Verb)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -9,8 +9,16 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldVerb holds the string denoting the verb field in the database.
+	FieldVerb = "verb"
 	// EdgeRoles holds the string denoting the roles edge name in mutations.
 	EdgeRoles = "roles"
+	// EdgeGrants holds the string denoting the grants edge name in mutations.
+	EdgeGrants = "grants"
+	// EdgeService holds the string denoting the service edge name in mutations.
+	EdgeService = "service"
+	// EdgeResourceType holds the string denoting the resource_type edge name in mutations.
+	EdgeResourceType = "resource_type"
 	// Table holds the table name of the permission in the database.
 	Table = "permissions"
 	// RolesTable is the table the holds the roles relation/edge. The primary key declared below.
@@ -18,12 +26,41 @@ const (
 	// RolesInverseTable is the table name for the Role entity.
 	// It exists in this package in order to avoid circular dependency with the "role" package.
 	RolesInverseTable = "roles"
+	// GrantsTable is the table the holds the grants relation/edge.
+	GrantsTable = "permission_grants"
+	// GrantsInverseTable is the table name for the PermissionGrant entity.
+	// It exists in this package in order to avoid circular dependency with the "permissiongrant" package.
+	GrantsInverseTable = "permission_grants"
+	// GrantsColumn is the table column denoting the grants relation/edge.
+	GrantsColumn = "permission_grants"
+	// ServiceTable is the table the holds the service relation/edge.
+	ServiceTable = "permissions"
+	// ServiceInverseTable is the table name for the Service entity.
+	// It exists in this package in order to avoid circular dependency with the "service" package.
+	ServiceInverseTable = "services"
+	// ServiceColumn is the table column denoting the service relation/edge.
+	ServiceColumn = "service_permissions"
+	// ResourceTypeTable is the table the holds the resource_type relation/edge.
+	ResourceTypeTable = "permissions"
+	// ResourceTypeInverseTable is the table name for the ResourceType entity.
+	// It exists in this package in order to avoid circular dependency with the "resourcetype" package.
+	ResourceTypeInverseTable = "resource_types"
+	// ResourceTypeColumn is the table column denoting the resource_type relation/edge.
+	ResourceTypeColumn = "resource_type_permissions"
 )
 
 // Columns holds all SQL columns for permission fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldVerb,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "permissions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"resource_type_permissions",
+	"service_permissions",
 }
 
 var (
@@ -39,10 +76,17 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultVerb holds the default value on creation for the "verb" field.
+	DefaultVerb string
 )
@@ -98,6 +98,13 @@ func Name(v string) predicate.Permission {
 	})
 }
 
+// Verb applies equality check predicate on the "verb" field. It's identical to VerbEQ.
+func Verb(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVerb), v))
+	})
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Permission {
 	return predicate.Permission(func(s *sql.Selector) {
@@ -209,6 +216,117 @@ func NameContainsFold(v string) predicate.Permission {
 	})
 }
 
+// VerbEQ applies the EQ predicate on the "verb" field.
+func VerbEQ(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVerb), v))
+	})
+}
+
+// VerbNEQ applies the NEQ predicate on the "verb" field.
+func VerbNEQ(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVerb), v))
+	})
+}
+
+// VerbIn applies the In predicate on the "verb" field.
+func VerbIn(vs ...string) predicate.Permission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Permission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVerb), v...))
+	})
+}
+
+// VerbNotIn applies the NotIn predicate on the "verb" field.
+func VerbNotIn(vs ...string) predicate.Permission {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Permission(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVerb), v...))
+	})
+}
+
+// VerbGT applies the GT predicate on the "verb" field.
+func VerbGT(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVerb), v))
+	})
+}
+
+// VerbGTE applies the GTE predicate on the "verb" field.
+func VerbGTE(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVerb), v))
+	})
+}
+
+// VerbLT applies the LT predicate on the "verb" field.
+func VerbLT(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVerb), v))
+	})
+}
+
+// VerbLTE applies the LTE predicate on the "verb" field.
+func VerbLTE(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVerb), v))
+	})
+}
+
+// VerbContains applies the Contains predicate on the "verb" field.
+func VerbContains(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldVerb), v))
+	})
+}
+
+// VerbHasPrefix applies the HasPrefix predicate on the "verb" field.
+func VerbHasPrefix(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldVerb), v))
+	})
+}
+
+// VerbHasSuffix applies the HasSuffix predicate on the "verb" field.
+func VerbHasSuffix(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldVerb), v))
+	})
+}
+
+// VerbEqualFold applies the EqualFold predicate on the "verb" field.
+func VerbEqualFold(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldVerb), v))
+	})
+}
+
+// VerbContainsFold applies the ContainsFold predicate on the "verb" field.
+func VerbContainsFold(v string) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldVerb), v))
+	})
+}
+
 // HasRoles applies the HasEdge predicate on the "roles" edge.
 func HasRoles() predicate.Permission {
 	return predicate.Permission(func(s *sql.Selector) {
@@ -237,6 +355,90 @@ func HasRolesWith(preds ...predicate.Role) predicate.Permission {
 	})
 }
 
+// HasGrants applies the HasEdge predicate on the "grants" edge.
+func HasGrants() predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GrantsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GrantsTable, GrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGrantsWith applies the HasEdge predicate on the "grants" edge with a given conditions (other predicates).
+func HasGrantsWith(preds ...predicate.PermissionGrant) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GrantsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GrantsTable, GrantsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasService applies the HasEdge predicate on the "service" edge.
+func HasService() predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ServiceTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ServiceTable, ServiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServiceWith applies the HasEdge predicate on the "service" edge with a given conditions (other predicates).
+func HasServiceWith(preds ...predicate.Service) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ServiceInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ServiceTable, ServiceColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResourceType applies the HasEdge predicate on the "resource_type" edge.
+func HasResourceType() predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ResourceTypeTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResourceTypeTable, ResourceTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceTypeWith applies the HasEdge predicate on the "resource_type" edge with a given conditions (other predicates).
+func HasResourceTypeWith(preds ...predicate.ResourceType) predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ResourceTypeInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResourceTypeTable, ResourceTypeColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Permission) predicate.Permission {
 	return predicate.Permission(func(s *sql.Selector) {
@@ -10,7 +10,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
+	"github.com/rosstimothy/iam/ent/resourcetype"
 	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/service"
 )
 
 // PermissionCreate is the builder for creating a Permission entity.
@@ -26,6 +29,20 @@ func (pc *PermissionCreate) SetName(s string) *PermissionCreate {
 	return pc
 }
 
+// SetVerb sets the "verb" field.
+func (pc *PermissionCreate) SetVerb(s string) *PermissionCreate {
+	pc.mutation.SetVerb(s)
+	return pc
+}
+
+// SetNillableVerb sets the "verb" field if the given value is not nil.
+func (pc *PermissionCreate) SetNillableVerb(s *string) *PermissionCreate {
+	if s != nil {
+		pc.SetVerb(*s)
+	}
+	return pc
+}
+
 // AddRoleIDs adds the "roles" edge to the Role entity by IDs.
 func (pc *PermissionCreate) AddRoleIDs(ids ...int) *PermissionCreate {
 	pc.mutation.AddRoleIDs(ids...)
@@ -41,6 +58,59 @@ func (pc *PermissionCreate) AddRoles(r ...*Role) *PermissionCreate {
 	return pc.AddRoleIDs(ids...)
 }
 
+// AddGrantIDs adds the "grants" edge to the PermissionGrant entity by IDs.
+func (pc *PermissionCreate) AddGrantIDs(ids ...int) *PermissionCreate {
+	pc.mutation.AddGrantIDs(ids...)
+	return pc
+}
+
+// AddGrants adds the "grants" edges to the PermissionGrant entity.
+func (pc *PermissionCreate) AddGrants(p ...*PermissionGrant) *PermissionCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddGrantIDs(ids...)
+}
+
+// SetServiceID sets the "service" edge to the Service entity by ID.
+func (pc *PermissionCreate) SetServiceID(id int) *PermissionCreate {
+	pc.mutation.SetServiceID(id)
+	return pc
+}
+
+// SetNillableServiceID sets the "service" edge to the Service entity by ID if the given value is not nil.
+func (pc *PermissionCreate) SetNillableServiceID(id *int) *PermissionCreate {
+	if id != nil {
+		pc = pc.SetServiceID(*id)
+	}
+	return pc
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (pc *PermissionCreate) SetService(s *Service) *PermissionCreate {
+	return pc.SetServiceID(s.ID)
+}
+
+// SetResourceTypeID sets the "resource_type" edge to the ResourceType entity by ID.
+func (pc *PermissionCreate) SetResourceTypeID(id int) *PermissionCreate {
+	pc.mutation.SetResourceTypeID(id)
+	return pc
+}
+
+// SetNillableResourceTypeID sets the "resource_type" edge to the ResourceType entity by ID if the given value is not nil.
+func (pc *PermissionCreate) SetNillableResourceTypeID(id *int) *PermissionCreate {
+	if id != nil {
+		pc = pc.SetResourceTypeID(*id)
+	}
+	return pc
+}
+
+// SetResourceType sets the "resource_type" edge to the ResourceType entity.
+func (pc *PermissionCreate) SetResourceType(r *ResourceType) *PermissionCreate {
+	return pc.SetResourceTypeID(r.ID)
+}
+
 // Mutation returns the PermissionMutation object of the builder.
 func (pc *PermissionCreate) Mutation() *PermissionMutation {
 	return pc.mutation
@@ -52,6 +122,7 @@ func (pc *PermissionCreate) Save(ctx context.Context) (*Permission, error) {
 		err  error
 		node *Permission
 	)
+	pc.defaults()
 	if len(pc.hooks) == 0 {
 		if err = pc.check(); err != nil {
 			return nil, err
@@ -90,6 +161,14 @@ func (pc *PermissionCreate) SaveX(ctx context.Context) *Permission {
 	return v
 }
 
+// defaults sets the default values of the builder before save.
+func (pc *PermissionCreate) defaults() {
+	if _, ok := pc.mutation.Verb(); !ok {
+		v := permission.DefaultVerb
+		pc.mutation.SetVerb(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (pc *PermissionCreate) check() error {
 	if _, ok := pc.mutation.Name(); !ok {
@@ -100,6 +179,9 @@ func (pc *PermissionCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
 		}
 	}
+	if _, ok := pc.mutation.Verb(); !ok {
+		return &ValidationError{Name: "verb", err: errors.New("ent: missing required field \"verb\"")}
+	}
 	return nil
 }
 
@@ -135,6 +217,14 @@ func (pc *PermissionCreate) createSpec() (*Permission, *sqlgraph.CreateSpec) {
 		})
 		_node.Name = value
 	}
+	if value, ok := pc.mutation.Verb(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: permission.FieldVerb,
+		})
+		_node.Verb = value
+	}
 	if nodes := pc.mutation.RolesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -154,6 +244,65 @@ func (pc *PermissionCreate) createSpec() (*Permission, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.GrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   permission.GrantsTable,
+			Columns: []string{permission.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   permission.ServiceTable,
+			Columns: []string{permission.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: service.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.service_permissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ResourceTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   permission.ResourceTypeTable,
+			Columns: []string{permission.ResourceTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resourcetype.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.resource_type_permissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -171,6 +320,7 @@ func (pcb *PermissionCreateBulk) Save(ctx context.Context) ([]*Permission, error
 	for i := range pcb.builders {
 		func(i int, root context.Context) {
 			builder := pcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*PermissionMutation)
 				if !ok {
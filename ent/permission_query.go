@@ -13,8 +13,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
 	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/resourcetype"
 	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/service"
 )
 
 // PermissionQuery is the builder for querying Permission entities.
@@ -27,7 +30,11 @@ type PermissionQuery struct {
 	fields     []string
 	predicates []predicate.Permission
 	// eager-loading edges.
-	withRoles *RoleQuery
+	withRoles        *RoleQuery
+	withGrants       *PermissionGrantQuery
+	withService      *ServiceQuery
+	withResourceType *ResourceTypeQuery
+	withFKs          bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +93,72 @@ func (pq *PermissionQuery) QueryRoles() *RoleQuery {
 	return query
 }
 
+// QueryGrants chains the current query on the "grants" edge.
+func (pq *PermissionQuery) QueryGrants() *PermissionGrantQuery {
+	query := &PermissionGrantQuery{config: pq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permission.Table, permission.FieldID, selector),
+			sqlgraph.To(permissiongrant.Table, permissiongrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, permission.GrantsTable, permission.GrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryService chains the current query on the "service" edge.
+func (pq *PermissionQuery) QueryService() *ServiceQuery {
+	query := &ServiceQuery{config: pq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permission.Table, permission.FieldID, selector),
+			sqlgraph.To(service.Table, service.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, permission.ServiceTable, permission.ServiceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryResourceType chains the current query on the "resource_type" edge.
+func (pq *PermissionQuery) QueryResourceType() *ResourceTypeQuery {
+	query := &ResourceTypeQuery{config: pq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permission.Table, permission.FieldID, selector),
+			sqlgraph.To(resourcetype.Table, resourcetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, permission.ResourceTypeTable, permission.ResourceTypeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Permission entity from the query.
 // Returns a *NotFoundError when no Permission was found.
 func (pq *PermissionQuery) First(ctx context.Context) (*Permission, error) {
@@ -262,12 +335,15 @@ func (pq *PermissionQuery) Clone() *PermissionQuery {
 		return nil
 	}
 	return &PermissionQuery{
-		config:     pq.config,
-		limit:      pq.limit,
-		offset:     pq.offset,
-		order:      append([]OrderFunc{}, pq.order...),
-		predicates: append([]predicate.Permission{}, pq.predicates...),
-		withRoles:  pq.withRoles.Clone(),
+		config:           pq.config,
+		limit:            pq.limit,
+		offset:           pq.offset,
+		order:            append([]OrderFunc{}, pq.order...),
+		predicates:       append([]predicate.Permission{}, pq.predicates...),
+		withRoles:        pq.withRoles.Clone(),
+		withGrants:       pq.withGrants.Clone(),
+		withService:      pq.withService.Clone(),
+		withResourceType: pq.withResourceType.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -285,6 +361,39 @@ func (pq *PermissionQuery) WithRoles(opts ...func(*RoleQuery)) *PermissionQuery
 	return pq
 }
 
+// WithGrants tells the query-builder to eager-load the nodes that are connected to
+// the "grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PermissionQuery) WithGrants(opts ...func(*PermissionGrantQuery)) *PermissionQuery {
+	query := &PermissionGrantQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withGrants = query
+	return pq
+}
+
+// WithService tells the query-builder to eager-load the nodes that are connected to
+// the "service" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PermissionQuery) WithService(opts ...func(*ServiceQuery)) *PermissionQuery {
+	query := &ServiceQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withService = query
+	return pq
+}
+
+// WithResourceType tells the query-builder to eager-load the nodes that are connected to
+// the "resource_type" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PermissionQuery) WithResourceType(opts ...func(*ResourceTypeQuery)) *PermissionQuery {
+	query := &ResourceTypeQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withResourceType = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -349,11 +458,21 @@ func (pq *PermissionQuery) prepareQuery(ctx context.Context) error {
 func (pq *PermissionQuery) sqlAll(ctx context.Context) ([]*Permission, error) {
 	var (
 		nodes       = []*Permission{}
+		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			pq.withRoles != nil,
+			pq.withGrants != nil,
+			pq.withService != nil,
+			pq.withResourceType != nil,
 		}
 	)
+	if pq.withService != nil || pq.withResourceType != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, permission.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &Permission{config: pq.config}
 		nodes = append(nodes, node)
@@ -439,6 +558,93 @@ func (pq *PermissionQuery) sqlAll(ctx context.Context) ([]*Permission, error) {
 		}
 	}
 
+	if query := pq.withGrants; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Permission)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Grants = []*PermissionGrant{}
+		}
+		query.withFKs = true
+		query.Where(predicate.PermissionGrant(func(s *sql.Selector) {
+			s.Where(sql.InValues(permission.GrantsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.permission_grants
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "permission_grants" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "permission_grants" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Grants = append(node.Edges.Grants, n)
+		}
+	}
+
+	if query := pq.withService; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Permission)
+		for i := range nodes {
+			if nodes[i].service_permissions == nil {
+				continue
+			}
+			fk := *nodes[i].service_permissions
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(service.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "service_permissions" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Service = n
+			}
+		}
+	}
+
+	if query := pq.withResourceType; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Permission)
+		for i := range nodes {
+			if nodes[i].resource_type_permissions == nil {
+				continue
+			}
+			fk := *nodes[i].resource_type_permissions
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(resourcetype.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "resource_type_permissions" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.ResourceType = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -10,8 +10,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
 	"github.com/rosstimothy/iam/ent/predicate"
+	"github.com/rosstimothy/iam/ent/resourcetype"
 	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/service"
 )
 
 // PermissionUpdate is the builder for updating Permission entities.
@@ -27,6 +30,20 @@ func (pu *PermissionUpdate) Where(ps ...predicate.Permission) *PermissionUpdate
 	return pu
 }
 
+// SetVerb sets the "verb" field.
+func (pu *PermissionUpdate) SetVerb(s string) *PermissionUpdate {
+	pu.mutation.SetVerb(s)
+	return pu
+}
+
+// SetNillableVerb sets the "verb" field if the given value is not nil.
+func (pu *PermissionUpdate) SetNillableVerb(s *string) *PermissionUpdate {
+	if s != nil {
+		pu.SetVerb(*s)
+	}
+	return pu
+}
+
 // AddRoleIDs adds the "roles" edge to the Role entity by IDs.
 func (pu *PermissionUpdate) AddRoleIDs(ids ...int) *PermissionUpdate {
 	pu.mutation.AddRoleIDs(ids...)
@@ -42,6 +59,59 @@ func (pu *PermissionUpdate) AddRoles(r ...*Role) *PermissionUpdate {
 	return pu.AddRoleIDs(ids...)
 }
 
+// AddGrantIDs adds the "grants" edge to the PermissionGrant entity by IDs.
+func (pu *PermissionUpdate) AddGrantIDs(ids ...int) *PermissionUpdate {
+	pu.mutation.AddGrantIDs(ids...)
+	return pu
+}
+
+// AddGrants adds the "grants" edges to the PermissionGrant entity.
+func (pu *PermissionUpdate) AddGrants(p ...*PermissionGrant) *PermissionUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddGrantIDs(ids...)
+}
+
+// SetServiceID sets the "service" edge to the Service entity by ID.
+func (pu *PermissionUpdate) SetServiceID(id int) *PermissionUpdate {
+	pu.mutation.SetServiceID(id)
+	return pu
+}
+
+// SetNillableServiceID sets the "service" edge to the Service entity by ID if the given value is not nil.
+func (pu *PermissionUpdate) SetNillableServiceID(id *int) *PermissionUpdate {
+	if id != nil {
+		pu = pu.SetServiceID(*id)
+	}
+	return pu
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (pu *PermissionUpdate) SetService(s *Service) *PermissionUpdate {
+	return pu.SetServiceID(s.ID)
+}
+
+// SetResourceTypeID sets the "resource_type" edge to the ResourceType entity by ID.
+func (pu *PermissionUpdate) SetResourceTypeID(id int) *PermissionUpdate {
+	pu.mutation.SetResourceTypeID(id)
+	return pu
+}
+
+// SetNillableResourceTypeID sets the "resource_type" edge to the ResourceType entity by ID if the given value is not nil.
+func (pu *PermissionUpdate) SetNillableResourceTypeID(id *int) *PermissionUpdate {
+	if id != nil {
+		pu = pu.SetResourceTypeID(*id)
+	}
+	return pu
+}
+
+// SetResourceType sets the "resource_type" edge to the ResourceType entity.
+func (pu *PermissionUpdate) SetResourceType(r *ResourceType) *PermissionUpdate {
+	return pu.SetResourceTypeID(r.ID)
+}
+
 // Mutation returns the PermissionMutation object of the builder.
 func (pu *PermissionUpdate) Mutation() *PermissionMutation {
 	return pu.mutation
@@ -68,6 +138,39 @@ func (pu *PermissionUpdate) RemoveRoles(r ...*Role) *PermissionUpdate {
 	return pu.RemoveRoleIDs(ids...)
 }
 
+// ClearGrants clears all "grants" edges to the PermissionGrant entity.
+func (pu *PermissionUpdate) ClearGrants() *PermissionUpdate {
+	pu.mutation.ClearGrants()
+	return pu
+}
+
+// RemoveGrantIDs removes the "grants" edge to PermissionGrant entities by IDs.
+func (pu *PermissionUpdate) RemoveGrantIDs(ids ...int) *PermissionUpdate {
+	pu.mutation.RemoveGrantIDs(ids...)
+	return pu
+}
+
+// RemoveGrants removes "grants" edges to PermissionGrant entities.
+func (pu *PermissionUpdate) RemoveGrants(p ...*PermissionGrant) *PermissionUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveGrantIDs(ids...)
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (pu *PermissionUpdate) ClearService() *PermissionUpdate {
+	pu.mutation.ClearService()
+	return pu
+}
+
+// ClearResourceType clears the "resource_type" edge to the ResourceType entity.
+func (pu *PermissionUpdate) ClearResourceType() *PermissionUpdate {
+	pu.mutation.ClearResourceType()
+	return pu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PermissionUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -137,6 +240,13 @@ func (pu *PermissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := pu.mutation.Verb(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: permission.FieldVerb,
+		})
+	}
 	if pu.mutation.RolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -191,6 +301,130 @@ func (pu *PermissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   permission.GrantsTable,
+			Columns: []string{permission.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedGrantsIDs(); len(nodes) > 0 && !pu.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   permission.GrantsTable,
+			Columns: []string{permission.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.GrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   permission.GrantsTable,
+			Columns: []string{permission.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ServiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   permission.ServiceTable,
+			Columns: []string{permission.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: service.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   permission.ServiceTable,
+			Columns: []string{permission.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: service.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ResourceTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   permission.ResourceTypeTable,
+			Columns: []string{permission.ResourceTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resourcetype.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ResourceTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   permission.ResourceTypeTable,
+			Columns: []string{permission.ResourceTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resourcetype.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{permission.Label}
@@ -210,6 +444,20 @@ type PermissionUpdateOne struct {
 	mutation *PermissionMutation
 }
 
+// SetVerb sets the "verb" field.
+func (puo *PermissionUpdateOne) SetVerb(s string) *PermissionUpdateOne {
+	puo.mutation.SetVerb(s)
+	return puo
+}
+
+// SetNillableVerb sets the "verb" field if the given value is not nil.
+func (puo *PermissionUpdateOne) SetNillableVerb(s *string) *PermissionUpdateOne {
+	if s != nil {
+		puo.SetVerb(*s)
+	}
+	return puo
+}
+
 // AddRoleIDs adds the "roles" edge to the Role entity by IDs.
 func (puo *PermissionUpdateOne) AddRoleIDs(ids ...int) *PermissionUpdateOne {
 	puo.mutation.AddRoleIDs(ids...)
@@ -225,6 +473,59 @@ func (puo *PermissionUpdateOne) AddRoles(r ...*Role) *PermissionUpdateOne {
 	return puo.AddRoleIDs(ids...)
 }
 
+// AddGrantIDs adds the "grants" edge to the PermissionGrant entity by IDs.
+func (puo *PermissionUpdateOne) AddGrantIDs(ids ...int) *PermissionUpdateOne {
+	puo.mutation.AddGrantIDs(ids...)
+	return puo
+}
+
+// AddGrants adds the "grants" edges to the PermissionGrant entity.
+func (puo *PermissionUpdateOne) AddGrants(p ...*PermissionGrant) *PermissionUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddGrantIDs(ids...)
+}
+
+// SetServiceID sets the "service" edge to the Service entity by ID.
+func (puo *PermissionUpdateOne) SetServiceID(id int) *PermissionUpdateOne {
+	puo.mutation.SetServiceID(id)
+	return puo
+}
+
+// SetNillableServiceID sets the "service" edge to the Service entity by ID if the given value is not nil.
+func (puo *PermissionUpdateOne) SetNillableServiceID(id *int) *PermissionUpdateOne {
+	if id != nil {
+		puo = puo.SetServiceID(*id)
+	}
+	return puo
+}
+
+// SetService sets the "service" edge to the Service entity.
+func (puo *PermissionUpdateOne) SetService(s *Service) *PermissionUpdateOne {
+	return puo.SetServiceID(s.ID)
+}
+
+// SetResourceTypeID sets the "resource_type" edge to the ResourceType entity by ID.
+func (puo *PermissionUpdateOne) SetResourceTypeID(id int) *PermissionUpdateOne {
+	puo.mutation.SetResourceTypeID(id)
+	return puo
+}
+
+// SetNillableResourceTypeID sets the "resource_type" edge to the ResourceType entity by ID if the given value is not nil.
+func (puo *PermissionUpdateOne) SetNillableResourceTypeID(id *int) *PermissionUpdateOne {
+	if id != nil {
+		puo = puo.SetResourceTypeID(*id)
+	}
+	return puo
+}
+
+// SetResourceType sets the "resource_type" edge to the ResourceType entity.
+func (puo *PermissionUpdateOne) SetResourceType(r *ResourceType) *PermissionUpdateOne {
+	return puo.SetResourceTypeID(r.ID)
+}
+
 // Mutation returns the PermissionMutation object of the builder.
 func (puo *PermissionUpdateOne) Mutation() *PermissionMutation {
 	return puo.mutation
@@ -251,6 +552,39 @@ func (puo *PermissionUpdateOne) RemoveRoles(r ...*Role) *PermissionUpdateOne {
 	return puo.RemoveRoleIDs(ids...)
 }
 
+// ClearGrants clears all "grants" edges to the PermissionGrant entity.
+func (puo *PermissionUpdateOne) ClearGrants() *PermissionUpdateOne {
+	puo.mutation.ClearGrants()
+	return puo
+}
+
+// RemoveGrantIDs removes the "grants" edge to PermissionGrant entities by IDs.
+func (puo *PermissionUpdateOne) RemoveGrantIDs(ids ...int) *PermissionUpdateOne {
+	puo.mutation.RemoveGrantIDs(ids...)
+	return puo
+}
+
+// RemoveGrants removes "grants" edges to PermissionGrant entities.
+func (puo *PermissionUpdateOne) RemoveGrants(p ...*PermissionGrant) *PermissionUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveGrantIDs(ids...)
+}
+
+// ClearService clears the "service" edge to the Service entity.
+func (puo *PermissionUpdateOne) ClearService() *PermissionUpdateOne {
+	puo.mutation.ClearService()
+	return puo
+}
+
+// ClearResourceType clears the "resource_type" edge to the ResourceType entity.
+func (puo *PermissionUpdateOne) ClearResourceType() *PermissionUpdateOne {
+	puo.mutation.ClearResourceType()
+	return puo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (puo *PermissionUpdateOne) Select(field string, fields ...string) *PermissionUpdateOne {
@@ -344,6 +678,13 @@ func (puo *PermissionUpdateOne) sqlSave(ctx context.Context) (_node *Permission,
 			}
 		}
 	}
+	if value, ok := puo.mutation.Verb(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: permission.FieldVerb,
+		})
+	}
 	if puo.mutation.RolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -398,6 +739,130 @@ func (puo *PermissionUpdateOne) sqlSave(ctx context.Context) (_node *Permission,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   permission.GrantsTable,
+			Columns: []string{permission.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedGrantsIDs(); len(nodes) > 0 && !puo.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   permission.GrantsTable,
+			Columns: []string{permission.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.GrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   permission.GrantsTable,
+			Columns: []string{permission.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ServiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   permission.ServiceTable,
+			Columns: []string{permission.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: service.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ServiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   permission.ServiceTable,
+			Columns: []string{permission.ServiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: service.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ResourceTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   permission.ResourceTypeTable,
+			Columns: []string{permission.ResourceTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resourcetype.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ResourceTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   permission.ResourceTypeTable,
+			Columns: []string{permission.ResourceTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: resourcetype.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Permission{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
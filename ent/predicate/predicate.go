@@ -6,8 +6,32 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ChangeSet is the predicate function for changeset builders.
+type ChangeSet func(*sql.Selector)
+
 // Permission is the predicate function for permission builders.
 type Permission func(*sql.Selector)
 
+// PermissionGrant is the predicate function for permissiongrant builders.
+type PermissionGrant func(*sql.Selector)
+
+// ResourceType is the predicate function for resourcetype builders.
+type ResourceType func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
+
+// RoleChange is the predicate function for rolechange builders.
+type RoleChange func(*sql.Selector)
+
+// RoleRevision is the predicate function for rolerevision builders.
+type RoleRevision func(*sql.Selector)
+
+// Service is the predicate function for service builders.
+type Service func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
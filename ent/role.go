@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/rosstimothy/iam/ent/role"
@@ -22,9 +23,15 @@ type Role struct {
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// Stage holds the value of the "stage" field.
-	Stage int `json:"stage,omitempty"`
+	Stage role.Stage `json:"stage,omitempty"`
 	// Etag holds the value of the "etag" field.
 	Etag []byte `json:"etag,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope role.Scope `json:"scope,omitempty"`
+	// Parent holds the value of the "parent" field.
+	Parent string `json:"parent,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RoleQuery when eager-loading is set.
 	Edges RoleEdges `json:"edges"`
@@ -34,9 +41,17 @@ type Role struct {
 type RoleEdges struct {
 	// Permissions holds the value of the permissions edge.
 	Permissions []*Permission `json:"permissions,omitempty"`
+	// Supersets holds the value of the supersets edge.
+	Supersets []*Role `json:"supersets,omitempty"`
+	// Subsets holds the value of the subsets edge.
+	Subsets []*Role `json:"subsets,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*RoleRevision `json:"revisions,omitempty"`
+	// Grants holds the value of the grants edge.
+	Grants []*PermissionGrant `json:"grants,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [5]bool
 }
 
 // PermissionsOrErr returns the Permissions value or an error if the edge
@@ -48,6 +63,42 @@ func (e RoleEdges) PermissionsOrErr() ([]*Permission, error) {
 	return nil, &NotLoadedError{edge: "permissions"}
 }
 
+// SupersetsOrErr returns the Supersets value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) SupersetsOrErr() ([]*Role, error) {
+	if e.loadedTypes[1] {
+		return e.Supersets, nil
+	}
+	return nil, &NotLoadedError{edge: "supersets"}
+}
+
+// SubsetsOrErr returns the Subsets value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) SubsetsOrErr() ([]*Role, error) {
+	if e.loadedTypes[2] {
+		return e.Subsets, nil
+	}
+	return nil, &NotLoadedError{edge: "subsets"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) RevisionsOrErr() ([]*RoleRevision, error) {
+	if e.loadedTypes[3] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// GrantsOrErr returns the Grants value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) GrantsOrErr() ([]*PermissionGrant, error) {
+	if e.loadedTypes[4] {
+		return e.Grants, nil
+	}
+	return nil, &NotLoadedError{edge: "grants"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Role) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -55,10 +106,12 @@ func (*Role) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case role.FieldEtag:
 			values[i] = new([]byte)
-		case role.FieldID, role.FieldStage:
+		case role.FieldID:
 			values[i] = new(sql.NullInt64)
-		case role.FieldName, role.FieldTitle, role.FieldDescription:
+		case role.FieldName, role.FieldTitle, role.FieldDescription, role.FieldStage, role.FieldScope, role.FieldParent:
 			values[i] = new(sql.NullString)
+		case role.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Role", columns[i])
 		}
@@ -99,10 +152,10 @@ func (r *Role) assignValues(columns []string, values []interface{}) error {
 				r.Description = value.String
 			}
 		case role.FieldStage:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
+			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field stage", values[i])
 			} else if value.Valid {
-				r.Stage = int(value.Int64)
+				r.Stage = role.Stage(value.String)
 			}
 		case role.FieldEtag:
 			if value, ok := values[i].(*[]byte); !ok {
@@ -110,6 +163,25 @@ func (r *Role) assignValues(columns []string, values []interface{}) error {
 			} else if value != nil {
 				r.Etag = *value
 			}
+		case role.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				r.Scope = role.Scope(value.String)
+			}
+		case role.FieldParent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent", values[i])
+			} else if value.Valid {
+				r.Parent = value.String
+			}
+		case role.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				r.DeletedAt = new(time.Time)
+				*r.DeletedAt = value.Time
+			}
 		}
 	}
 	return nil
@@ -120,6 +192,26 @@ func (r *Role) QueryPermissions() *PermissionQuery {
 	return (&RoleClient{config: r.config}).QueryPermissions(r)
 }
 
+// QuerySupersets queries the "supersets" edge of the Role entity.
+func (r *Role) QuerySupersets() *RoleQuery {
+	return (&RoleClient{config: r.config}).QuerySupersets(r)
+}
+
+// QuerySubsets queries the "subsets" edge of the Role entity.
+func (r *Role) QuerySubsets() *RoleQuery {
+	return (&RoleClient{config: r.config}).QuerySubsets(r)
+}
+
+// QueryRevisions queries the "revisions" edge of the Role entity.
+func (r *Role) QueryRevisions() *RoleRevisionQuery {
+	return (&RoleClient{config: r.config}).QueryRevisions(r)
+}
+
+// QueryGrants queries the "grants" edge of the Role entity.
+func (r *Role) QueryGrants() *PermissionGrantQuery {
+	return (&RoleClient{config: r.config}).QueryGrants(r)
+}
+
 // Update returns a builder for updating this Role.
 // Note that you need to call Role.Unwrap() before calling this method if this Role
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -153,6 +245,14 @@ func (r *Role) String() string {
 	builder.WriteString(fmt.Sprintf("%v", r.Stage))
 	builder.WriteString(", etag=")
 	builder.WriteString(fmt.Sprintf("%v", r.Etag))
+	builder.WriteString(", scope=")
+	builder.WriteString(fmt.Sprintf("%v", r.Scope))
+	builder.WriteString(", parent=")
+	builder.WriteString(r.Parent)
+	if v := r.DeletedAt; v != nil {
+		builder.WriteString(", deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -2,6 +2,10 @@
 
 package role
 
+import (
+	"fmt"
+)
+
 const (
 	// Label holds the string label denoting the role type in the database.
 	Label = "role"
@@ -17,8 +21,22 @@ const (
 	FieldStage = "stage"
 	// FieldEtag holds the string denoting the etag field in the database.
 	FieldEtag = "etag"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldParent holds the string denoting the parent field in the database.
+	FieldParent = "parent"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgePermissions holds the string denoting the permissions edge name in mutations.
 	EdgePermissions = "permissions"
+	// EdgeSupersets holds the string denoting the supersets edge name in mutations.
+	EdgeSupersets = "supersets"
+	// EdgeSubsets holds the string denoting the subsets edge name in mutations.
+	EdgeSubsets = "subsets"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeGrants holds the string denoting the grants edge name in mutations.
+	EdgeGrants = "grants"
 	// Table holds the table name of the role in the database.
 	Table = "roles"
 	// PermissionsTable is the table the holds the permissions relation/edge. The primary key declared below.
@@ -26,6 +44,24 @@ const (
 	// PermissionsInverseTable is the table name for the Permission entity.
 	// It exists in this package in order to avoid circular dependency with the "permission" package.
 	PermissionsInverseTable = "permissions"
+	// SupersetsTable is the table the holds the supersets relation/edge. The primary key declared below.
+	SupersetsTable = "role_subsets"
+	// SubsetsTable is the table the holds the subsets relation/edge. The primary key declared below.
+	SubsetsTable = "role_subsets"
+	// RevisionsTable is the table the holds the revisions relation/edge.
+	RevisionsTable = "role_revisions"
+	// RevisionsInverseTable is the table name for the RoleRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "rolerevision" package.
+	RevisionsInverseTable = "role_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "role_revisions"
+	// GrantsTable is the table the holds the grants relation/edge.
+	GrantsTable = "permission_grants"
+	// GrantsInverseTable is the table name for the PermissionGrant entity.
+	// It exists in this package in order to avoid circular dependency with the "permissiongrant" package.
+	GrantsInverseTable = "permission_grants"
+	// GrantsColumn is the table column denoting the grants relation/edge.
+	GrantsColumn = "role_grants"
 )
 
 // Columns holds all SQL columns for role fields.
@@ -36,12 +72,21 @@ var Columns = []string{
 	FieldDescription,
 	FieldStage,
 	FieldEtag,
+	FieldScope,
+	FieldParent,
+	FieldDeletedAt,
 }
 
 var (
 	// PermissionsPrimaryKey and PermissionsColumn2 are the table columns denoting the
 	// primary key for the permissions relation (M2M).
 	PermissionsPrimaryKey = []string{"role_id", "permission_id"}
+	// SupersetsPrimaryKey and SupersetsColumn2 are the table columns denoting the
+	// primary key for the supersets relation (M2M).
+	SupersetsPrimaryKey = []string{"role_id", "superset_id"}
+	// SubsetsPrimaryKey and SubsetsColumn2 are the table columns denoting the
+	// primary key for the subsets relation (M2M).
+	SubsetsPrimaryKey = []string{"role_id", "superset_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -57,8 +102,60 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
-	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	TitleValidator func(string) error
-	// StageValidator is a validator for the "stage" field. It is called by the builders before save.
-	StageValidator func(int) error
+	// DefaultParent holds the default value on creation for the "parent" field.
+	DefaultParent string
+)
+
+// Stage defines the type for the "stage" enum field.
+type Stage string
+
+// Stage values.
+const (
+	StageALPHA      Stage = "ALPHA"
+	StageBETA       Stage = "BETA"
+	StageGA         Stage = "GA"
+	StageDEPRECATED Stage = "DEPRECATED"
+	StageDISABLED   Stage = "DISABLED"
+	StageEAP        Stage = "EAP"
 )
+
+func (s Stage) String() string {
+	return string(s)
+}
+
+// StageValidator is a validator for the "stage" field enum values. It is called by the builders before save.
+func StageValidator(s Stage) error {
+	switch s {
+	case StageALPHA, StageBETA, StageGA, StageDEPRECATED, StageDISABLED, StageEAP:
+		return nil
+	default:
+		return fmt.Errorf("role: invalid enum value for stage field: %q", s)
+	}
+}
+
+// Scope defines the type for the "scope" enum field.
+type Scope string
+
+// ScopePredefined is the default value of the Scope enum.
+const DefaultScope = ScopePredefined
+
+// Scope values.
+const (
+	ScopePredefined   Scope = "predefined"
+	ScopeOrganization Scope = "organization"
+	ScopeProject      Scope = "project"
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+// ScopeValidator is a validator for the "scope" field enum values. It is called by the builders before save.
+func ScopeValidator(s Scope) error {
+	switch s {
+	case ScopePredefined, ScopeOrganization, ScopeProject:
+		return nil
+	default:
+		return fmt.Errorf("role: invalid enum value for scope field: %q", s)
+	}
+}
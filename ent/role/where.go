@@ -3,6 +3,8 @@
 package role
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/rosstimothy/iam/ent/predicate"
@@ -112,17 +114,24 @@ func Description(v string) predicate.Role {
 	})
 }
 
-// Stage applies equality check predicate on the "stage" field. It's identical to StageEQ.
-func Stage(v int) predicate.Role {
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v []byte) predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldStage), v))
+		s.Where(sql.EQ(s.C(FieldEtag), v))
 	})
 }
 
-// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
-func Etag(v []byte) predicate.Role {
+// Parent applies equality check predicate on the "parent" field. It's identical to ParentEQ.
+func Parent(v string) predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldEtag), v))
+		s.Where(sql.EQ(s.C(FieldParent), v))
+	})
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
 	})
 }
 
@@ -460,21 +469,21 @@ func DescriptionContainsFold(v string) predicate.Role {
 }
 
 // StageEQ applies the EQ predicate on the "stage" field.
-func StageEQ(v int) predicate.Role {
+func StageEQ(v Stage) predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldStage), v))
 	})
 }
 
 // StageNEQ applies the NEQ predicate on the "stage" field.
-func StageNEQ(v int) predicate.Role {
+func StageNEQ(v Stage) predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldStage), v))
 	})
 }
 
 // StageIn applies the In predicate on the "stage" field.
-func StageIn(vs ...int) predicate.Role {
+func StageIn(vs ...Stage) predicate.Role {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -491,7 +500,7 @@ func StageIn(vs ...int) predicate.Role {
 }
 
 // StageNotIn applies the NotIn predicate on the "stage" field.
-func StageNotIn(vs ...int) predicate.Role {
+func StageNotIn(vs ...Stage) predicate.Role {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -507,34 +516,6 @@ func StageNotIn(vs ...int) predicate.Role {
 	})
 }
 
-// StageGT applies the GT predicate on the "stage" field.
-func StageGT(v int) predicate.Role {
-	return predicate.Role(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldStage), v))
-	})
-}
-
-// StageGTE applies the GTE predicate on the "stage" field.
-func StageGTE(v int) predicate.Role {
-	return predicate.Role(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldStage), v))
-	})
-}
-
-// StageLT applies the LT predicate on the "stage" field.
-func StageLT(v int) predicate.Role {
-	return predicate.Role(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldStage), v))
-	})
-}
-
-// StageLTE applies the LTE predicate on the "stage" field.
-func StageLTE(v int) predicate.Role {
-	return predicate.Role(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldStage), v))
-	})
-}
-
 // EtagEQ applies the EQ predicate on the "etag" field.
 func EtagEQ(v []byte) predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
@@ -611,6 +592,255 @@ func EtagLTE(v []byte) predicate.Role {
 	})
 }
 
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v Scope) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldScope), v))
+	})
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v Scope) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldScope), v))
+	})
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...Scope) predicate.Role {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Role(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldScope), v...))
+	})
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...Scope) predicate.Role {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Role(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldScope), v...))
+	})
+}
+
+// ParentEQ applies the EQ predicate on the "parent" field.
+func ParentEQ(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldParent), v))
+	})
+}
+
+// ParentNEQ applies the NEQ predicate on the "parent" field.
+func ParentNEQ(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldParent), v))
+	})
+}
+
+// ParentIn applies the In predicate on the "parent" field.
+func ParentIn(vs ...string) predicate.Role {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Role(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldParent), v...))
+	})
+}
+
+// ParentNotIn applies the NotIn predicate on the "parent" field.
+func ParentNotIn(vs ...string) predicate.Role {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Role(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldParent), v...))
+	})
+}
+
+// ParentGT applies the GT predicate on the "parent" field.
+func ParentGT(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldParent), v))
+	})
+}
+
+// ParentGTE applies the GTE predicate on the "parent" field.
+func ParentGTE(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldParent), v))
+	})
+}
+
+// ParentLT applies the LT predicate on the "parent" field.
+func ParentLT(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldParent), v))
+	})
+}
+
+// ParentLTE applies the LTE predicate on the "parent" field.
+func ParentLTE(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldParent), v))
+	})
+}
+
+// ParentContains applies the Contains predicate on the "parent" field.
+func ParentContains(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldParent), v))
+	})
+}
+
+// ParentHasPrefix applies the HasPrefix predicate on the "parent" field.
+func ParentHasPrefix(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldParent), v))
+	})
+}
+
+// ParentHasSuffix applies the HasSuffix predicate on the "parent" field.
+func ParentHasSuffix(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldParent), v))
+	})
+}
+
+// ParentEqualFold applies the EqualFold predicate on the "parent" field.
+func ParentEqualFold(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldParent), v))
+	})
+}
+
+// ParentContainsFold applies the ContainsFold predicate on the "parent" field.
+func ParentContainsFold(v string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldParent), v))
+	})
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Role {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Role(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Role {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Role(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeletedAt), v...))
+	})
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedAt), v))
+	})
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeletedAt)))
+	})
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeletedAt)))
+	})
+}
+
 // HasPermissions applies the HasEdge predicate on the "permissions" edge.
 func HasPermissions() predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
@@ -639,6 +869,118 @@ func HasPermissionsWith(preds ...predicate.Permission) predicate.Role {
 	})
 }
 
+// HasSupersets applies the HasEdge predicate on the "supersets" edge.
+func HasSupersets() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SupersetsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, SupersetsTable, SupersetsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSupersetsWith applies the HasEdge predicate on the "supersets" edge with a given conditions (other predicates).
+func HasSupersetsWith(preds ...predicate.Role) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, SupersetsTable, SupersetsPrimaryKey...),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSubsets applies the HasEdge predicate on the "subsets" edge.
+func HasSubsets() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SubsetsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, SubsetsTable, SubsetsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubsetsWith applies the HasEdge predicate on the "subsets" edge with a given conditions (other predicates).
+func HasSubsetsWith(preds ...predicate.Role) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, SubsetsTable, SubsetsPrimaryKey...),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RevisionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.RoleRevision) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RevisionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGrants applies the HasEdge predicate on the "grants" edge.
+func HasGrants() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GrantsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GrantsTable, GrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGrantsWith applies the HasEdge predicate on the "grants" edge with a given conditions (other predicates).
+func HasGrantsWith(preds ...predicate.PermissionGrant) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GrantsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GrantsTable, GrantsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Role) predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
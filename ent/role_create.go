@@ -6,11 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
 	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolerevision"
 )
 
 // RoleCreate is the builder for creating a Role entity.
@@ -39,8 +42,8 @@ func (rc *RoleCreate) SetDescription(s string) *RoleCreate {
 }
 
 // SetStage sets the "stage" field.
-func (rc *RoleCreate) SetStage(i int) *RoleCreate {
-	rc.mutation.SetStage(i)
+func (rc *RoleCreate) SetStage(r role.Stage) *RoleCreate {
+	rc.mutation.SetStage(r)
 	return rc
 }
 
@@ -50,6 +53,48 @@ func (rc *RoleCreate) SetEtag(b []byte) *RoleCreate {
 	return rc
 }
 
+// SetScope sets the "scope" field.
+func (rc *RoleCreate) SetScope(r role.Scope) *RoleCreate {
+	rc.mutation.SetScope(r)
+	return rc
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableScope(r *role.Scope) *RoleCreate {
+	if r != nil {
+		rc.SetScope(*r)
+	}
+	return rc
+}
+
+// SetParent sets the "parent" field.
+func (rc *RoleCreate) SetParent(s string) *RoleCreate {
+	rc.mutation.SetParent(s)
+	return rc
+}
+
+// SetNillableParent sets the "parent" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableParent(s *string) *RoleCreate {
+	if s != nil {
+		rc.SetParent(*s)
+	}
+	return rc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rc *RoleCreate) SetDeletedAt(t time.Time) *RoleCreate {
+	rc.mutation.SetDeletedAt(t)
+	return rc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableDeletedAt(t *time.Time) *RoleCreate {
+	if t != nil {
+		rc.SetDeletedAt(*t)
+	}
+	return rc
+}
+
 // AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
 func (rc *RoleCreate) AddPermissionIDs(ids ...int) *RoleCreate {
 	rc.mutation.AddPermissionIDs(ids...)
@@ -65,6 +110,66 @@ func (rc *RoleCreate) AddPermissions(p ...*Permission) *RoleCreate {
 	return rc.AddPermissionIDs(ids...)
 }
 
+// AddSupersetIDs adds the "supersets" edge to the Role entity by IDs.
+func (rc *RoleCreate) AddSupersetIDs(ids ...int) *RoleCreate {
+	rc.mutation.AddSupersetIDs(ids...)
+	return rc
+}
+
+// AddSupersets adds the "supersets" edges to the Role entity.
+func (rc *RoleCreate) AddSupersets(r ...*Role) *RoleCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddSupersetIDs(ids...)
+}
+
+// AddSubsetIDs adds the "subsets" edge to the Role entity by IDs.
+func (rc *RoleCreate) AddSubsetIDs(ids ...int) *RoleCreate {
+	rc.mutation.AddSubsetIDs(ids...)
+	return rc
+}
+
+// AddSubsets adds the "subsets" edges to the Role entity.
+func (rc *RoleCreate) AddSubsets(r ...*Role) *RoleCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddSubsetIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the RoleRevision entity by IDs.
+func (rc *RoleCreate) AddRevisionIDs(ids ...int) *RoleCreate {
+	rc.mutation.AddRevisionIDs(ids...)
+	return rc
+}
+
+// AddRevisions adds the "revisions" edges to the RoleRevision entity.
+func (rc *RoleCreate) AddRevisions(r ...*RoleRevision) *RoleCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddRevisionIDs(ids...)
+}
+
+// AddGrantIDs adds the "grants" edge to the PermissionGrant entity by IDs.
+func (rc *RoleCreate) AddGrantIDs(ids ...int) *RoleCreate {
+	rc.mutation.AddGrantIDs(ids...)
+	return rc
+}
+
+// AddGrants adds the "grants" edges to the PermissionGrant entity.
+func (rc *RoleCreate) AddGrants(p ...*PermissionGrant) *RoleCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return rc.AddGrantIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (rc *RoleCreate) Mutation() *RoleMutation {
 	return rc.mutation
@@ -76,6 +181,7 @@ func (rc *RoleCreate) Save(ctx context.Context) (*Role, error) {
 		err  error
 		node *Role
 	)
+	rc.defaults()
 	if len(rc.hooks) == 0 {
 		if err = rc.check(); err != nil {
 			return nil, err
@@ -114,6 +220,18 @@ func (rc *RoleCreate) SaveX(ctx context.Context) *Role {
 	return v
 }
 
+// defaults sets the default values of the builder before save.
+func (rc *RoleCreate) defaults() {
+	if _, ok := rc.mutation.Scope(); !ok {
+		v := role.DefaultScope
+		rc.mutation.SetScope(v)
+	}
+	if _, ok := rc.mutation.Parent(); !ok {
+		v := role.DefaultParent
+		rc.mutation.SetParent(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (rc *RoleCreate) check() error {
 	if _, ok := rc.mutation.Name(); !ok {
@@ -127,11 +245,6 @@ func (rc *RoleCreate) check() error {
 	if _, ok := rc.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New("ent: missing required field \"title\"")}
 	}
-	if v, ok := rc.mutation.Title(); ok {
-		if err := role.TitleValidator(v); err != nil {
-			return &ValidationError{Name: "title", err: fmt.Errorf("ent: validator failed for field \"title\": %w", err)}
-		}
-	}
 	if _, ok := rc.mutation.Description(); !ok {
 		return &ValidationError{Name: "description", err: errors.New("ent: missing required field \"description\"")}
 	}
@@ -146,6 +259,17 @@ func (rc *RoleCreate) check() error {
 	if _, ok := rc.mutation.Etag(); !ok {
 		return &ValidationError{Name: "etag", err: errors.New("ent: missing required field \"etag\"")}
 	}
+	if _, ok := rc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New("ent: missing required field \"scope\"")}
+	}
+	if v, ok := rc.mutation.Scope(); ok {
+		if err := role.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf("ent: validator failed for field \"scope\": %w", err)}
+		}
+	}
+	if _, ok := rc.mutation.Parent(); !ok {
+		return &ValidationError{Name: "parent", err: errors.New("ent: missing required field \"parent\"")}
+	}
 	return nil
 }
 
@@ -199,7 +323,7 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 	}
 	if value, ok := rc.mutation.Stage(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeEnum,
 			Value:  value,
 			Column: role.FieldStage,
 		})
@@ -213,6 +337,30 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		})
 		_node.Etag = value
 	}
+	if value, ok := rc.mutation.Scope(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: role.FieldScope,
+		})
+		_node.Scope = value
+	}
+	if value, ok := rc.mutation.Parent(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: role.FieldParent,
+		})
+		_node.Parent = value
+	}
+	if value, ok := rc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: role.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
 	if nodes := rc.mutation.PermissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -232,6 +380,82 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.SupersetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SupersetsTable,
+			Columns: role.SupersetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.SubsetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.SubsetsTable,
+			Columns: role.SubsetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RevisionsTable,
+			Columns: []string{role.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: rolerevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.GrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.GrantsTable,
+			Columns: []string{role.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -249,6 +473,7 @@ func (rcb *RoleCreateBulk) Save(ctx context.Context) ([]*Role, error) {
 	for i := range rcb.builders {
 		func(i int, root context.Context) {
 			builder := rcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*RoleMutation)
 				if !ok {
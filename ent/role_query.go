@@ -13,8 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
 	"github.com/rosstimothy/iam/ent/predicate"
 	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolerevision"
 )
 
 // RoleQuery is the builder for querying Role entities.
@@ -28,6 +30,10 @@ type RoleQuery struct {
 	predicates []predicate.Role
 	// eager-loading edges.
 	withPermissions *PermissionQuery
+	withSupersets   *RoleQuery
+	withSubsets     *RoleQuery
+	withRevisions   *RoleRevisionQuery
+	withGrants      *PermissionGrantQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +92,94 @@ func (rq *RoleQuery) QueryPermissions() *PermissionQuery {
 	return query
 }
 
+// QuerySupersets chains the current query on the "supersets" edge.
+func (rq *RoleQuery) QuerySupersets() *RoleQuery {
+	query := &RoleQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, selector),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, role.SupersetsTable, role.SupersetsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySubsets chains the current query on the "subsets" edge.
+func (rq *RoleQuery) QuerySubsets() *RoleQuery {
+	query := &RoleQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, selector),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, role.SubsetsTable, role.SubsetsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (rq *RoleQuery) QueryRevisions() *RoleRevisionQuery {
+	query := &RoleRevisionQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, selector),
+			sqlgraph.To(rolerevision.Table, rolerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.RevisionsTable, role.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGrants chains the current query on the "grants" edge.
+func (rq *RoleQuery) QueryGrants() *PermissionGrantQuery {
+	query := &PermissionGrantQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, selector),
+			sqlgraph.To(permissiongrant.Table, permissiongrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.GrantsTable, role.GrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Role entity from the query.
 // Returns a *NotFoundError when no Role was found.
 func (rq *RoleQuery) First(ctx context.Context) (*Role, error) {
@@ -268,6 +362,10 @@ func (rq *RoleQuery) Clone() *RoleQuery {
 		order:           append([]OrderFunc{}, rq.order...),
 		predicates:      append([]predicate.Role{}, rq.predicates...),
 		withPermissions: rq.withPermissions.Clone(),
+		withSupersets:   rq.withSupersets.Clone(),
+		withSubsets:     rq.withSubsets.Clone(),
+		withRevisions:   rq.withRevisions.Clone(),
+		withGrants:      rq.withGrants.Clone(),
 		// clone intermediate query.
 		sql:  rq.sql.Clone(),
 		path: rq.path,
@@ -285,6 +383,50 @@ func (rq *RoleQuery) WithPermissions(opts ...func(*PermissionQuery)) *RoleQuery
 	return rq
 }
 
+// WithSupersets tells the query-builder to eager-load the nodes that are connected to
+// the "supersets" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoleQuery) WithSupersets(opts ...func(*RoleQuery)) *RoleQuery {
+	query := &RoleQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withSupersets = query
+	return rq
+}
+
+// WithSubsets tells the query-builder to eager-load the nodes that are connected to
+// the "subsets" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoleQuery) WithSubsets(opts ...func(*RoleQuery)) *RoleQuery {
+	query := &RoleQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withSubsets = query
+	return rq
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoleQuery) WithRevisions(opts ...func(*RoleRevisionQuery)) *RoleQuery {
+	query := &RoleRevisionQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withRevisions = query
+	return rq
+}
+
+// WithGrants tells the query-builder to eager-load the nodes that are connected to
+// the "grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoleQuery) WithGrants(opts ...func(*PermissionGrantQuery)) *RoleQuery {
+	query := &PermissionGrantQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withGrants = query
+	return rq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -350,8 +492,12 @@ func (rq *RoleQuery) sqlAll(ctx context.Context) ([]*Role, error) {
 	var (
 		nodes       = []*Role{}
 		_spec       = rq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [5]bool{
 			rq.withPermissions != nil,
+			rq.withSupersets != nil,
+			rq.withSubsets != nil,
+			rq.withRevisions != nil,
+			rq.withGrants != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -439,6 +585,194 @@ func (rq *RoleQuery) sqlAll(ctx context.Context) ([]*Role, error) {
 		}
 	}
 
+	if query := rq.withSupersets; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*Role, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+			node.Edges.Supersets = []*Role{}
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*Role)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: true,
+				Table:   role.SupersetsTable,
+				Columns: role.SupersetsPrimaryKey,
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.InValues(role.SupersetsPrimaryKey[1], fks...))
+			},
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		if err := sqlgraph.QueryEdges(ctx, rq.driver, _spec); err != nil {
+			return nil, fmt.Errorf(`query edges "supersets": %w`, err)
+		}
+		query.Where(role.IDIn(edgeids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := edges[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "supersets" node returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Supersets = append(nodes[i].Edges.Supersets, n)
+			}
+		}
+	}
+
+	if query := rq.withSubsets; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*Role, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+			node.Edges.Subsets = []*Role{}
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*Role)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: false,
+				Table:   role.SubsetsTable,
+				Columns: role.SubsetsPrimaryKey,
+			},
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.InValues(role.SubsetsPrimaryKey[0], fks...))
+			},
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		if err := sqlgraph.QueryEdges(ctx, rq.driver, _spec); err != nil {
+			return nil, fmt.Errorf(`query edges "subsets": %w`, err)
+		}
+		query.Where(role.IDIn(edgeids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := edges[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected "subsets" node returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Subsets = append(nodes[i].Edges.Subsets, n)
+			}
+		}
+	}
+
+	if query := rq.withRevisions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Role)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Revisions = []*RoleRevision{}
+		}
+		query.withFKs = true
+		query.Where(predicate.RoleRevision(func(s *sql.Selector) {
+			s.Where(sql.InValues(role.RevisionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.role_revisions
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "role_revisions" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "role_revisions" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Revisions = append(node.Edges.Revisions, n)
+		}
+	}
+
+	if query := rq.withGrants; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Role)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Grants = []*PermissionGrant{}
+		}
+		query.withFKs = true
+		query.Where(predicate.PermissionGrant(func(s *sql.Selector) {
+			s.Where(sql.InValues(role.GrantsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.role_grants
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "role_grants" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "role_grants" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Grants = append(node.Edges.Grants, n)
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -5,13 +5,16 @@ package ent
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/permissiongrant"
 	"github.com/rosstimothy/iam/ent/predicate"
 	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolerevision"
 )
 
 // RoleUpdate is the builder for updating Role entities.
@@ -40,15 +43,8 @@ func (ru *RoleUpdate) SetDescription(s string) *RoleUpdate {
 }
 
 // SetStage sets the "stage" field.
-func (ru *RoleUpdate) SetStage(i int) *RoleUpdate {
-	ru.mutation.ResetStage()
-	ru.mutation.SetStage(i)
-	return ru
-}
-
-// AddStage adds i to the "stage" field.
-func (ru *RoleUpdate) AddStage(i int) *RoleUpdate {
-	ru.mutation.AddStage(i)
+func (ru *RoleUpdate) SetStage(r role.Stage) *RoleUpdate {
+	ru.mutation.SetStage(r)
 	return ru
 }
 
@@ -58,6 +54,26 @@ func (ru *RoleUpdate) SetEtag(b []byte) *RoleUpdate {
 	return ru
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ru *RoleUpdate) SetDeletedAt(t time.Time) *RoleUpdate {
+	ru.mutation.SetDeletedAt(t)
+	return ru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ru *RoleUpdate) SetNillableDeletedAt(t *time.Time) *RoleUpdate {
+	if t != nil {
+		ru.SetDeletedAt(*t)
+	}
+	return ru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ru *RoleUpdate) ClearDeletedAt() *RoleUpdate {
+	ru.mutation.ClearDeletedAt()
+	return ru
+}
+
 // AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
 func (ru *RoleUpdate) AddPermissionIDs(ids ...int) *RoleUpdate {
 	ru.mutation.AddPermissionIDs(ids...)
@@ -73,6 +89,66 @@ func (ru *RoleUpdate) AddPermissions(p ...*Permission) *RoleUpdate {
 	return ru.AddPermissionIDs(ids...)
 }
 
+// AddSupersetIDs adds the "supersets" edge to the Role entity by IDs.
+func (ru *RoleUpdate) AddSupersetIDs(ids ...int) *RoleUpdate {
+	ru.mutation.AddSupersetIDs(ids...)
+	return ru
+}
+
+// AddSupersets adds the "supersets" edges to the Role entity.
+func (ru *RoleUpdate) AddSupersets(r ...*Role) *RoleUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddSupersetIDs(ids...)
+}
+
+// AddSubsetIDs adds the "subsets" edge to the Role entity by IDs.
+func (ru *RoleUpdate) AddSubsetIDs(ids ...int) *RoleUpdate {
+	ru.mutation.AddSubsetIDs(ids...)
+	return ru
+}
+
+// AddSubsets adds the "subsets" edges to the Role entity.
+func (ru *RoleUpdate) AddSubsets(r ...*Role) *RoleUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddSubsetIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the RoleRevision entity by IDs.
+func (ru *RoleUpdate) AddRevisionIDs(ids ...int) *RoleUpdate {
+	ru.mutation.AddRevisionIDs(ids...)
+	return ru
+}
+
+// AddRevisions adds the "revisions" edges to the RoleRevision entity.
+func (ru *RoleUpdate) AddRevisions(r ...*RoleRevision) *RoleUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddRevisionIDs(ids...)
+}
+
+// AddGrantIDs adds the "grants" edge to the PermissionGrant entity by IDs.
+func (ru *RoleUpdate) AddGrantIDs(ids ...int) *RoleUpdate {
+	ru.mutation.AddGrantIDs(ids...)
+	return ru
+}
+
+// AddGrants adds the "grants" edges to the PermissionGrant entity.
+func (ru *RoleUpdate) AddGrants(p ...*PermissionGrant) *RoleUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ru.AddGrantIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ru *RoleUpdate) Mutation() *RoleMutation {
 	return ru.mutation
@@ -99,6 +175,90 @@ func (ru *RoleUpdate) RemovePermissions(p ...*Permission) *RoleUpdate {
 	return ru.RemovePermissionIDs(ids...)
 }
 
+// ClearSupersets clears all "supersets" edges to the Role entity.
+func (ru *RoleUpdate) ClearSupersets() *RoleUpdate {
+	ru.mutation.ClearSupersets()
+	return ru
+}
+
+// RemoveSupersetIDs removes the "supersets" edge to Role entities by IDs.
+func (ru *RoleUpdate) RemoveSupersetIDs(ids ...int) *RoleUpdate {
+	ru.mutation.RemoveSupersetIDs(ids...)
+	return ru
+}
+
+// RemoveSupersets removes "supersets" edges to Role entities.
+func (ru *RoleUpdate) RemoveSupersets(r ...*Role) *RoleUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveSupersetIDs(ids...)
+}
+
+// ClearSubsets clears all "subsets" edges to the Role entity.
+func (ru *RoleUpdate) ClearSubsets() *RoleUpdate {
+	ru.mutation.ClearSubsets()
+	return ru
+}
+
+// RemoveSubsetIDs removes the "subsets" edge to Role entities by IDs.
+func (ru *RoleUpdate) RemoveSubsetIDs(ids ...int) *RoleUpdate {
+	ru.mutation.RemoveSubsetIDs(ids...)
+	return ru
+}
+
+// RemoveSubsets removes "subsets" edges to Role entities.
+func (ru *RoleUpdate) RemoveSubsets(r ...*Role) *RoleUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveSubsetIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the RoleRevision entity.
+func (ru *RoleUpdate) ClearRevisions() *RoleUpdate {
+	ru.mutation.ClearRevisions()
+	return ru
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to RoleRevision entities by IDs.
+func (ru *RoleUpdate) RemoveRevisionIDs(ids ...int) *RoleUpdate {
+	ru.mutation.RemoveRevisionIDs(ids...)
+	return ru
+}
+
+// RemoveRevisions removes "revisions" edges to RoleRevision entities.
+func (ru *RoleUpdate) RemoveRevisions(r ...*RoleRevision) *RoleUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveRevisionIDs(ids...)
+}
+
+// ClearGrants clears all "grants" edges to the PermissionGrant entity.
+func (ru *RoleUpdate) ClearGrants() *RoleUpdate {
+	ru.mutation.ClearGrants()
+	return ru
+}
+
+// RemoveGrantIDs removes the "grants" edge to PermissionGrant entities by IDs.
+func (ru *RoleUpdate) RemoveGrantIDs(ids ...int) *RoleUpdate {
+	ru.mutation.RemoveGrantIDs(ids...)
+	return ru
+}
+
+// RemoveGrants removes "grants" edges to PermissionGrant entities.
+func (ru *RoleUpdate) RemoveGrants(p ...*PermissionGrant) *RoleUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ru.RemoveGrantIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *RoleUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -158,11 +318,6 @@ func (ru *RoleUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ru *RoleUpdate) check() error {
-	if v, ok := ru.mutation.Title(); ok {
-		if err := role.TitleValidator(v); err != nil {
-			return &ValidationError{Name: "title", err: fmt.Errorf("ent: validator failed for field \"title\": %w", err)}
-		}
-	}
 	if v, ok := ru.mutation.Stage(); ok {
 		if err := role.StageValidator(v); err != nil {
 			return &ValidationError{Name: "stage", err: fmt.Errorf("ent: validator failed for field \"stage\": %w", err)}
@@ -205,14 +360,7 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if value, ok := ru.mutation.Stage(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
-			Value:  value,
-			Column: role.FieldStage,
-		})
-	}
-	if value, ok := ru.mutation.AddedStage(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeEnum,
 			Value:  value,
 			Column: role.FieldStage,
 		})
@@ -224,6 +372,19 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: role.FieldEtag,
 		})
 	}
+	if value, ok := ru.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: role.FieldDeletedAt,
+		})
+	}
+	if ru.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: role.FieldDeletedAt,
+		})
+	}
 	if ru.mutation.PermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -278,6 +439,222 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.SupersetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SupersetsTable,
+			Columns: role.SupersetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedSupersetsIDs(); len(nodes) > 0 && !ru.mutation.SupersetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SupersetsTable,
+			Columns: role.SupersetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.SupersetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SupersetsTable,
+			Columns: role.SupersetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.SubsetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.SubsetsTable,
+			Columns: role.SubsetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedSubsetsIDs(); len(nodes) > 0 && !ru.mutation.SubsetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.SubsetsTable,
+			Columns: role.SubsetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.SubsetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.SubsetsTable,
+			Columns: role.SubsetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RevisionsTable,
+			Columns: []string{role.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: rolerevision.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !ru.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RevisionsTable,
+			Columns: []string{role.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: rolerevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RevisionsTable,
+			Columns: []string{role.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: rolerevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.GrantsTable,
+			Columns: []string{role.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedGrantsIDs(); len(nodes) > 0 && !ru.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.GrantsTable,
+			Columns: []string{role.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.GrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.GrantsTable,
+			Columns: []string{role.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{role.Label}
@@ -310,15 +687,8 @@ func (ruo *RoleUpdateOne) SetDescription(s string) *RoleUpdateOne {
 }
 
 // SetStage sets the "stage" field.
-func (ruo *RoleUpdateOne) SetStage(i int) *RoleUpdateOne {
-	ruo.mutation.ResetStage()
-	ruo.mutation.SetStage(i)
-	return ruo
-}
-
-// AddStage adds i to the "stage" field.
-func (ruo *RoleUpdateOne) AddStage(i int) *RoleUpdateOne {
-	ruo.mutation.AddStage(i)
+func (ruo *RoleUpdateOne) SetStage(r role.Stage) *RoleUpdateOne {
+	ruo.mutation.SetStage(r)
 	return ruo
 }
 
@@ -328,6 +698,26 @@ func (ruo *RoleUpdateOne) SetEtag(b []byte) *RoleUpdateOne {
 	return ruo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ruo *RoleUpdateOne) SetDeletedAt(t time.Time) *RoleUpdateOne {
+	ruo.mutation.SetDeletedAt(t)
+	return ruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ruo *RoleUpdateOne) SetNillableDeletedAt(t *time.Time) *RoleUpdateOne {
+	if t != nil {
+		ruo.SetDeletedAt(*t)
+	}
+	return ruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ruo *RoleUpdateOne) ClearDeletedAt() *RoleUpdateOne {
+	ruo.mutation.ClearDeletedAt()
+	return ruo
+}
+
 // AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
 func (ruo *RoleUpdateOne) AddPermissionIDs(ids ...int) *RoleUpdateOne {
 	ruo.mutation.AddPermissionIDs(ids...)
@@ -343,6 +733,66 @@ func (ruo *RoleUpdateOne) AddPermissions(p ...*Permission) *RoleUpdateOne {
 	return ruo.AddPermissionIDs(ids...)
 }
 
+// AddSupersetIDs adds the "supersets" edge to the Role entity by IDs.
+func (ruo *RoleUpdateOne) AddSupersetIDs(ids ...int) *RoleUpdateOne {
+	ruo.mutation.AddSupersetIDs(ids...)
+	return ruo
+}
+
+// AddSupersets adds the "supersets" edges to the Role entity.
+func (ruo *RoleUpdateOne) AddSupersets(r ...*Role) *RoleUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddSupersetIDs(ids...)
+}
+
+// AddSubsetIDs adds the "subsets" edge to the Role entity by IDs.
+func (ruo *RoleUpdateOne) AddSubsetIDs(ids ...int) *RoleUpdateOne {
+	ruo.mutation.AddSubsetIDs(ids...)
+	return ruo
+}
+
+// AddSubsets adds the "subsets" edges to the Role entity.
+func (ruo *RoleUpdateOne) AddSubsets(r ...*Role) *RoleUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddSubsetIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the RoleRevision entity by IDs.
+func (ruo *RoleUpdateOne) AddRevisionIDs(ids ...int) *RoleUpdateOne {
+	ruo.mutation.AddRevisionIDs(ids...)
+	return ruo
+}
+
+// AddRevisions adds the "revisions" edges to the RoleRevision entity.
+func (ruo *RoleUpdateOne) AddRevisions(r ...*RoleRevision) *RoleUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddRevisionIDs(ids...)
+}
+
+// AddGrantIDs adds the "grants" edge to the PermissionGrant entity by IDs.
+func (ruo *RoleUpdateOne) AddGrantIDs(ids ...int) *RoleUpdateOne {
+	ruo.mutation.AddGrantIDs(ids...)
+	return ruo
+}
+
+// AddGrants adds the "grants" edges to the PermissionGrant entity.
+func (ruo *RoleUpdateOne) AddGrants(p ...*PermissionGrant) *RoleUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ruo.AddGrantIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ruo *RoleUpdateOne) Mutation() *RoleMutation {
 	return ruo.mutation
@@ -369,6 +819,90 @@ func (ruo *RoleUpdateOne) RemovePermissions(p ...*Permission) *RoleUpdateOne {
 	return ruo.RemovePermissionIDs(ids...)
 }
 
+// ClearSupersets clears all "supersets" edges to the Role entity.
+func (ruo *RoleUpdateOne) ClearSupersets() *RoleUpdateOne {
+	ruo.mutation.ClearSupersets()
+	return ruo
+}
+
+// RemoveSupersetIDs removes the "supersets" edge to Role entities by IDs.
+func (ruo *RoleUpdateOne) RemoveSupersetIDs(ids ...int) *RoleUpdateOne {
+	ruo.mutation.RemoveSupersetIDs(ids...)
+	return ruo
+}
+
+// RemoveSupersets removes "supersets" edges to Role entities.
+func (ruo *RoleUpdateOne) RemoveSupersets(r ...*Role) *RoleUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveSupersetIDs(ids...)
+}
+
+// ClearSubsets clears all "subsets" edges to the Role entity.
+func (ruo *RoleUpdateOne) ClearSubsets() *RoleUpdateOne {
+	ruo.mutation.ClearSubsets()
+	return ruo
+}
+
+// RemoveSubsetIDs removes the "subsets" edge to Role entities by IDs.
+func (ruo *RoleUpdateOne) RemoveSubsetIDs(ids ...int) *RoleUpdateOne {
+	ruo.mutation.RemoveSubsetIDs(ids...)
+	return ruo
+}
+
+// RemoveSubsets removes "subsets" edges to Role entities.
+func (ruo *RoleUpdateOne) RemoveSubsets(r ...*Role) *RoleUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveSubsetIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the RoleRevision entity.
+func (ruo *RoleUpdateOne) ClearRevisions() *RoleUpdateOne {
+	ruo.mutation.ClearRevisions()
+	return ruo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to RoleRevision entities by IDs.
+func (ruo *RoleUpdateOne) RemoveRevisionIDs(ids ...int) *RoleUpdateOne {
+	ruo.mutation.RemoveRevisionIDs(ids...)
+	return ruo
+}
+
+// RemoveRevisions removes "revisions" edges to RoleRevision entities.
+func (ruo *RoleUpdateOne) RemoveRevisions(r ...*RoleRevision) *RoleUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveRevisionIDs(ids...)
+}
+
+// ClearGrants clears all "grants" edges to the PermissionGrant entity.
+func (ruo *RoleUpdateOne) ClearGrants() *RoleUpdateOne {
+	ruo.mutation.ClearGrants()
+	return ruo
+}
+
+// RemoveGrantIDs removes the "grants" edge to PermissionGrant entities by IDs.
+func (ruo *RoleUpdateOne) RemoveGrantIDs(ids ...int) *RoleUpdateOne {
+	ruo.mutation.RemoveGrantIDs(ids...)
+	return ruo
+}
+
+// RemoveGrants removes "grants" edges to PermissionGrant entities.
+func (ruo *RoleUpdateOne) RemoveGrants(p ...*PermissionGrant) *RoleUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ruo.RemoveGrantIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (ruo *RoleUpdateOne) Select(field string, fields ...string) *RoleUpdateOne {
@@ -435,11 +969,6 @@ func (ruo *RoleUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ruo *RoleUpdateOne) check() error {
-	if v, ok := ruo.mutation.Title(); ok {
-		if err := role.TitleValidator(v); err != nil {
-			return &ValidationError{Name: "title", err: fmt.Errorf("ent: validator failed for field \"title\": %w", err)}
-		}
-	}
 	if v, ok := ruo.mutation.Stage(); ok {
 		if err := role.StageValidator(v); err != nil {
 			return &ValidationError{Name: "stage", err: fmt.Errorf("ent: validator failed for field \"stage\": %w", err)}
@@ -499,14 +1028,7 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 	}
 	if value, ok := ruo.mutation.Stage(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
-			Value:  value,
-			Column: role.FieldStage,
-		})
-	}
-	if value, ok := ruo.mutation.AddedStage(); ok {
-		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
-			Type:   field.TypeInt,
+			Type:   field.TypeEnum,
 			Value:  value,
 			Column: role.FieldStage,
 		})
@@ -518,6 +1040,19 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 			Column: role.FieldEtag,
 		})
 	}
+	if value, ok := ruo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: role.FieldDeletedAt,
+		})
+	}
+	if ruo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: role.FieldDeletedAt,
+		})
+	}
 	if ruo.mutation.PermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -572,6 +1107,222 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.SupersetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SupersetsTable,
+			Columns: role.SupersetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedSupersetsIDs(); len(nodes) > 0 && !ruo.mutation.SupersetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SupersetsTable,
+			Columns: role.SupersetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.SupersetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SupersetsTable,
+			Columns: role.SupersetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.SubsetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.SubsetsTable,
+			Columns: role.SubsetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedSubsetsIDs(); len(nodes) > 0 && !ruo.mutation.SubsetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.SubsetsTable,
+			Columns: role.SubsetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.SubsetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.SubsetsTable,
+			Columns: role.SubsetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RevisionsTable,
+			Columns: []string{role.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: rolerevision.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !ruo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RevisionsTable,
+			Columns: []string{role.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: rolerevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RevisionsTable,
+			Columns: []string{role.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: rolerevision.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.GrantsTable,
+			Columns: []string{role.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedGrantsIDs(); len(nodes) > 0 && !ruo.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.GrantsTable,
+			Columns: []string{role.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.GrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.GrantsTable,
+			Columns: []string{role.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: permissiongrant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Role{config: ruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -3,33 +3,106 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/rosstimothy/iam/ent/changeset"
 	"github.com/rosstimothy/iam/ent/permission"
+	"github.com/rosstimothy/iam/ent/resourcetype"
 	"github.com/rosstimothy/iam/ent/role"
+	"github.com/rosstimothy/iam/ent/rolechange"
+	"github.com/rosstimothy/iam/ent/rolerevision"
 	"github.com/rosstimothy/iam/ent/schema"
+	"github.com/rosstimothy/iam/ent/service"
+	"github.com/rosstimothy/iam/ent/webhook"
+	"github.com/rosstimothy/iam/ent/webhookdelivery"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	changesetFields := schema.ChangeSet{}.Fields()
+	_ = changesetFields
+	// changesetDescCreatedAt is the schema descriptor for created_at field.
+	changesetDescCreatedAt := changesetFields[0].Descriptor()
+	// changeset.DefaultCreatedAt holds the default value on creation for the created_at field.
+	changeset.DefaultCreatedAt = changesetDescCreatedAt.Default.(func() time.Time)
 	permissionFields := schema.Permission{}.Fields()
 	_ = permissionFields
 	// permissionDescName is the schema descriptor for name field.
 	permissionDescName := permissionFields[0].Descriptor()
 	// permission.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	permission.NameValidator = permissionDescName.Validators[0].(func(string) error)
+	// permissionDescVerb is the schema descriptor for verb field.
+	permissionDescVerb := permissionFields[1].Descriptor()
+	// permission.DefaultVerb holds the default value on creation for the verb field.
+	permission.DefaultVerb = permissionDescVerb.Default.(string)
+	resourcetypeFields := schema.ResourceType{}.Fields()
+	_ = resourcetypeFields
+	// resourcetypeDescName is the schema descriptor for name field.
+	resourcetypeDescName := resourcetypeFields[0].Descriptor()
+	// resourcetype.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	resourcetype.NameValidator = resourcetypeDescName.Validators[0].(func(string) error)
 	roleFields := schema.Role{}.Fields()
 	_ = roleFields
 	// roleDescName is the schema descriptor for name field.
 	roleDescName := roleFields[0].Descriptor()
 	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	role.NameValidator = roleDescName.Validators[0].(func(string) error)
-	// roleDescTitle is the schema descriptor for title field.
-	roleDescTitle := roleFields[1].Descriptor()
-	// role.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	role.TitleValidator = roleDescTitle.Validators[0].(func(string) error)
-	// roleDescStage is the schema descriptor for stage field.
-	roleDescStage := roleFields[3].Descriptor()
-	// role.StageValidator is a validator for the "stage" field. It is called by the builders before save.
-	role.StageValidator = roleDescStage.Validators[0].(func(int) error)
+	// roleDescParent is the schema descriptor for parent field.
+	roleDescParent := roleFields[6].Descriptor()
+	// role.DefaultParent holds the default value on creation for the parent field.
+	role.DefaultParent = roleDescParent.Default.(string)
+	rolechangeFields := schema.RoleChange{}.Fields()
+	_ = rolechangeFields
+	// rolechangeDescRole is the schema descriptor for role field.
+	rolechangeDescRole := rolechangeFields[0].Descriptor()
+	// rolechange.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	rolechange.RoleValidator = rolechangeDescRole.Validators[0].(func(string) error)
+	rolerevisionFields := schema.RoleRevision{}.Fields()
+	_ = rolerevisionFields
+	// rolerevisionDescRevision is the schema descriptor for revision field.
+	rolerevisionDescRevision := rolerevisionFields[0].Descriptor()
+	// rolerevision.RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	rolerevision.RevisionValidator = rolerevisionDescRevision.Validators[0].(func(int) error)
+	// rolerevisionDescDeleted is the schema descriptor for deleted field.
+	rolerevisionDescDeleted := rolerevisionFields[6].Descriptor()
+	// rolerevision.DefaultDeleted holds the default value on creation for the deleted field.
+	rolerevision.DefaultDeleted = rolerevisionDescDeleted.Default.(bool)
+	// rolerevisionDescCreatedAt is the schema descriptor for created_at field.
+	rolerevisionDescCreatedAt := rolerevisionFields[7].Descriptor()
+	// rolerevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rolerevision.DefaultCreatedAt = rolerevisionDescCreatedAt.Default.(func() time.Time)
+	serviceFields := schema.Service{}.Fields()
+	_ = serviceFields
+	// serviceDescName is the schema descriptor for name field.
+	serviceDescName := serviceFields[0].Descriptor()
+	// service.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	service.NameValidator = serviceDescName.Validators[0].(func(string) error)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[0].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[1].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[5].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	webhookdelivery.AttemptsValidator = webhookdeliveryDescAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ChangeSet holds the schema definition for the ChangeSet entity, the
+// changes of the roles made by a single sync.
+type ChangeSet struct {
+	ent.Schema
+}
+
+// Fields of the ChangeSet.
+func (ChangeSet) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ChangeSet.
+func (ChangeSet) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("changes", RoleChange.Type),
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+// Indexes of the ChangeSet.
+func (ChangeSet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -15,6 +15,8 @@ type Permission struct {
 func (Permission) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").Immutable().NotEmpty().Unique(),
+		// verb is the last segment of the name such as get.
+		field.String("verb").Default(""),
 	}
 }
 
@@ -22,5 +24,8 @@ func (Permission) Fields() []ent.Field {
 func (Permission) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("roles", Role.Type).Ref("permissions"),
+		edge.To("grants", PermissionGrant.Type),
+		edge.From("service", Service.Type).Ref("permissions").Unique(),
+		edge.From("resource_type", ResourceType.Type).Ref("permissions").Unique(),
 	}
 }
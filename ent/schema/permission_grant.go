@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PermissionGrant holds the schema definition for the PermissionGrant
+// entity, the period during which a role included a permission.
+type PermissionGrant struct {
+	ent.Schema
+}
+
+// Fields of the PermissionGrant.
+func (PermissionGrant) Fields() []ent.Field {
+	return []ent.Field{
+		// valid_from is the time of the sync which first found the
+		// permission in the role.
+		field.Time("valid_from").Immutable(),
+		// valid_to is the time of the sync which found the permission
+		// removed from the role, nil while the role includes it.
+		field.Time("valid_to").Optional().Nillable(),
+	}
+}
+
+// Edges of the PermissionGrant.
+func (PermissionGrant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("role", Role.Type).Ref("grants").Unique().Required(),
+		edge.From("permission", Permission.Type).Ref("grants").Unique().Required(),
+	}
+}
+
+// Indexes of the PermissionGrant.
+func (PermissionGrant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("valid_from").Edges("role"),
+		index.Fields("valid_from").Edges("permission"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ResourceType holds the schema definition for the ResourceType entity,
+// the segments between the service and the verb of permission names
+// such as objects.
+type ResourceType struct {
+	ent.Schema
+}
+
+// Fields of the ResourceType.
+func (ResourceType) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().Immutable(),
+	}
+}
+
+// Edges of the ResourceType.
+func (ResourceType) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("service", Service.Type).Ref("resource_types").Unique().Required(),
+		edge.To("permissions", Permission.Type),
+	}
+}
+
+// Indexes of the ResourceType.
+func (ResourceType) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("service").Unique(),
+	}
+}
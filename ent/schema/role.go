@@ -15,10 +15,16 @@ type Role struct {
 func (Role) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").NotEmpty().Immutable().Unique(),
-		field.String("title").NotEmpty(),
+		field.String("title"),
 		field.String("description"),
-		field.Int("stage").NonNegative(),
+		// stage is the launch stage, named as in adminpb.Role_RoleLaunchStage.
+		field.Enum("stage").Values("ALPHA", "BETA", "GA", "DEPRECATED", "DISABLED", "EAP"),
 		field.Bytes("etag"),
+		field.Enum("scope").Values("predefined", "organization", "project").Default("predefined").Immutable(),
+		field.String("parent").Default("").Immutable(),
+		// deleted_at is set while the role is deleted upstream, the role
+		// keeps its last known permissions until it is undeleted.
+		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
 
@@ -26,5 +32,10 @@ func (Role) Fields() []ent.Field {
 func (Role) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("permissions", Permission.Type),
+		// subsets are the roles whose permissions are a strict subset of
+		// this role's permissions, with no other role in between.
+		edge.To("subsets", Role.Type).From("supersets"),
+		edge.To("revisions", RoleRevision.Type),
+		edge.To("grants", PermissionGrant.Type),
 	}
 }
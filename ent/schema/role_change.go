@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// RoleChange holds the schema definition for the RoleChange entity,
+// a change of a single role made by a sync.
+type RoleChange struct {
+	ent.Schema
+}
+
+// Fields of the RoleChange.
+func (RoleChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("role").NotEmpty().Immutable(),
+		field.Enum("kind").Values("added", "deleted", "undeleted", "updated").Immutable(),
+		field.String("title").Immutable(),
+		// previous_title is set if the change retitled the role.
+		field.String("previous_title").Optional().Nillable().Immutable(),
+		field.Enum("stage").Values("ALPHA", "BETA", "GA", "DEPRECATED", "DISABLED", "EAP").Immutable(),
+		// previous_stage is set if the change moved the role to another stage.
+		field.Enum("previous_stage").Values("ALPHA", "BETA", "GA", "DEPRECATED", "DISABLED", "EAP").Optional().Nillable().Immutable(),
+		field.JSON("permissions_added", []string{}).Optional().Immutable(),
+		field.JSON("permissions_removed", []string{}).Optional().Immutable(),
+	}
+}
+
+// Edges of the RoleChange.
+func (RoleChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("change_set", ChangeSet.Type).Ref("changes").Unique().Required(),
+	}
+}
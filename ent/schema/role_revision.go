@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoleRevision holds the schema definition for the RoleRevision entity,
+// a snapshot of a role taken by the sync whenever the role changed.
+type RoleRevision struct {
+	ent.Schema
+}
+
+// Fields of the RoleRevision.
+func (RoleRevision) Fields() []ent.Field {
+	return []ent.Field{
+		// revision numbers the snapshots of a role starting at 1.
+		field.Int("revision").Positive().Immutable(),
+		field.String("title").Immutable(),
+		field.String("description").Immutable(),
+		field.Enum("stage").Values("ALPHA", "BETA", "GA", "DEPRECATED", "DISABLED", "EAP").Immutable(),
+		field.Bytes("etag").Immutable(),
+		// permissions are the sorted names of the permissions of the role.
+		field.JSON("permissions", []string{}).Immutable(),
+		field.Bool("deleted").Default(false).Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the RoleRevision.
+func (RoleRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("role", Role.Type).Ref("revisions").Unique().Required(),
+	}
+}
+
+// Indexes of the RoleRevision.
+func (RoleRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("revision").Edges("role").Unique(),
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Service holds the schema definition for the Service entity,
+// the first segment of permission names such as storage.
+type Service struct {
+	ent.Schema
+}
+
+// Fields of the Service.
+func (Service) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().Immutable().Unique(),
+	}
+}
+
+// Edges of the Service.
+func (Service) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("resource_types", ResourceType.Type),
+		edge.To("permissions", Permission.Type),
+	}
+}
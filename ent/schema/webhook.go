@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Webhook holds the schema definition for the Webhook entity, a
+// subscription to the changes of the roles made by the syncs.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").NotEmpty().Immutable(),
+		// secret is the key of the HMAC signature of each delivery,
+		// stored in plaintext since it is needed to sign them.
+		field.String("secret").NotEmpty().Sensitive().Immutable(),
+		// role_patterns are glob patterns of the names of the roles
+		// whose changes are delivered, all roles if empty.
+		field.JSON("role_patterns", []string{}).Optional().Immutable(),
+		// permission_patterns are glob patterns of the permissions whose
+		// addition or removal is delivered, any change if empty.
+		field.JSON("permission_patterns", []string{}).Optional().Immutable(),
+		// kinds are the kinds of changes delivered, all kinds if empty.
+		field.JSON("kinds", []string{}).Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
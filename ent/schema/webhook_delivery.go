@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery
+// entity, the delivery of the changes of a change set to a webhook.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("status").Values("pending", "succeeded", "failed").Default("pending"),
+		field.Int("attempts").NonNegative().Default(0),
+		// next_attempt_at is the earliest time the pending delivery is attempted.
+		field.Time("next_attempt_at"),
+		// response_code is the status code of the response to the last attempt.
+		field.Int("response_code").Optional(),
+		// error describes why the last attempt failed.
+		field.String("error").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("delivered_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).Ref("deliveries").Unique().Required(),
+		edge.From("change_set", ChangeSet.Type).Ref("deliveries").Unique().Required(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -12,10 +12,26 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ChangeSet is the client for interacting with the ChangeSet builders.
+	ChangeSet *ChangeSetClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
+	// PermissionGrant is the client for interacting with the PermissionGrant builders.
+	PermissionGrant *PermissionGrantClient
+	// ResourceType is the client for interacting with the ResourceType builders.
+	ResourceType *ResourceTypeClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// RoleChange is the client for interacting with the RoleChange builders.
+	RoleChange *RoleChangeClient
+	// RoleRevision is the client for interacting with the RoleRevision builders.
+	RoleRevision *RoleRevisionClient
+	// Service is the client for interacting with the Service builders.
+	Service *ServiceClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,8 +167,16 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ChangeSet = NewChangeSetClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
+	tx.PermissionGrant = NewPermissionGrantClient(tx.config)
+	tx.ResourceType = NewResourceTypeClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.RoleChange = NewRoleChangeClient(tx.config)
+	tx.RoleRevision = NewRoleRevisionClient(tx.config)
+	tx.Service = NewServiceClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -162,7 +186,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Permission.QueryXXX(), the query will be executed
+// applies a query, for example: ChangeSet.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -6,7 +6,11 @@ require (
 	cloud.google.com/go v0.88.0
 	entgo.io/ent v0.8.0
 	github.com/go-chi/chi v1.5.4
+	github.com/go-sql-driver/mysql v1.6.0
+	github.com/lib/pq v1.10.2
 	github.com/mattn/go-sqlite3 v1.14.6
 	github.com/oklog/run v1.1.0
 	google.golang.org/genproto v0.0.0-20210721163202-f1cecdd8b78a
+	google.golang.org/protobuf v1.27.1
+	gopkg.in/yaml.v3 v3.0.1
 )
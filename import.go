@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rosstimothy/iam/adapters"
+	"github.com/rosstimothy/iam/app/command"
+)
+
+// importRoles syncs the roles found in gcloud role exports once and exits.
+func importRoles(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: iam import [flags] FILE...")
+		flags.PrintDefaults()
+	}
+	db := addDatabaseFlags(flags)
+	timeout := flags.Duration("timeout", time.Minute, "maximum duration of the import")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	client, err := openClient(db)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	source := adapters.NewFileRoleSource(flags.Args()...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	parents, err := source.Parents(ctx)
+	if err != nil {
+		return err
+	}
+
+	handler := command.NewUpdateRolesHandler(client, source)
+
+	return handler.Handle(ctx, command.UpdateRoles{Parents: parents})
+}
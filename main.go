@@ -2,101 +2,171 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
-	"net/http"
-	"time"
+	"os"
+	"strings"
 
-	"github.com/go-chi/chi"
-	"github.com/go-chi/chi/middleware"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
-	"github.com/oklog/run"
 
-	"github.com/rosstimothy/iam/app"
-	"github.com/rosstimothy/iam/app/command"
-	"github.com/rosstimothy/iam/app/query"
 	"github.com/rosstimothy/iam/ent"
-	"github.com/rosstimothy/iam/ports"
+	"github.com/rosstimothy/iam/migrations"
 )
 
 func main() {
-	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
-	if err != nil {
-		fmt.Printf("failed opening connection to sqlite: %v\n", err)
-		return
+	args := os.Args[1:]
+	cmd := "serve"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		cmd, args = args[0], args[1:]
 	}
-	defer client.Close()
-	// Run the auto migration tool.
-	if err := client.Schema.Create(context.Background()); err != nil {
-		fmt.Printf("failed creating schema resources: %v\n", err)
-		return
+
+	switch cmd {
+	case "serve":
+		serve(args)
+	case "import":
+		if err := importRoles(args); err != nil {
+			fmt.Printf("failed to import roles: %v\n", err)
+			os.Exit(1)
+		}
+	case "cover":
+		if err := cover(args); err != nil {
+			fmt.Printf("failed to combine roles: %v\n", err)
+			os.Exit(1)
+		}
+	case "migrate":
+		if err := migrate(args); err != nil {
+			fmt.Printf("failed to migrate: %v\n", err)
+			os.Exit(1)
+		}
+	default:
+		fmt.Printf("unknown command %q, expected one of serve, import, cover or migrate\n", cmd)
+		os.Exit(2)
 	}
+}
+
+// databaseConfig identifies the database the catalog is stored in.
+type databaseConfig struct {
+	driver      string
+	dsn         string
+	autoMigrate bool
+}
+
+// addDatabaseFlags registers the flags which configure the database on flags.
+// The defaults may be overridden by the IAM_DB_DRIVER and IAM_DB_DSN
+// environment variables.
+func addDatabaseFlags(flags *flag.FlagSet) *databaseConfig {
+	var cfg databaseConfig
+	flags.StringVar(&cfg.driver, "db-driver", envOrDefault("IAM_DB_DRIVER", dialect.SQLite), "database driver, one of sqlite3, postgres or mysql")
+	flags.StringVar(&cfg.dsn, "db-dsn", envOrDefault("IAM_DB_DSN", "file:iam.db?cache=shared&_fk=1"), "database data source name")
+	flags.BoolVar(&cfg.autoMigrate, "auto-migrate", false, "apply pending schema migrations instead of refusing to start")
+
+	return &cfg
+}
 
-	application := &app.Application{
-		Commands: app.Commands{
-			UpdateRoles: command.NewUpdateRolesHandler(client),
-		},
-		Queries: app.Queries{
-			RolesWithPermissions: query.NewRolesWithPermissionsHandler(client),
-			RoleByName:           query.NewRoleByNameHandler(client),
-		},
+func envOrDefault(key, def string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
 	}
 
-	apiRouter := chi.NewRouter()
+	return def
+}
+
+func openDB(cfg *databaseConfig) (*entsql.Driver, *migrations.Migrator, error) {
+	switch cfg.driver {
+	case dialect.SQLite, dialect.Postgres, dialect.MySQL:
+	default:
+		return nil, nil, fmt.Errorf("unsupported database driver %q", cfg.driver)
+	}
 
-	apiRouter.Use(
-		middleware.SetHeader("X-Content-Type-Options", "nosniff"),
-		middleware.SetHeader("X-Frame-Options", "deny"),
-		middleware.SetHeader("Content-Type", "application/json; charset=utf-8"),
-		middleware.RequestID,
-		middleware.RealIP,
-		middleware.Recoverer,
-		middleware.NoCache,
-	)
+	drv, err := entsql.Open(cfg.driver, cfg.dsn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed opening connection to %s: %w", cfg.driver, err)
+	}
+
+	migrator, err := migrations.NewMigrator(drv.DB(), cfg.driver)
+	if err != nil {
+		drv.Close()
+		return nil, nil, err
+	}
 
-	rootRouter := chi.NewRouter()
-	rootRouter.Mount("/v1", ports.NewHandlerForMux(ports.NewHttpServer(application), apiRouter))
+	return drv, migrator, nil
+}
 
-	srv := &http.Server{
-		Addr:    ":8080",
-		Handler: rootRouter,
+// openClient opens the configured database, refusing to use it unless its
+// schema matches the latest migration known to this binary.
+func openClient(cfg *databaseConfig) (*ent.Client, error) {
+	drv, migrator, err := openDB(cfg)
+	if err != nil {
+		return nil, err
 	}
 
-	var g run.Group
-	{
-		g.Add(func() error {
-			fmt.Printf("Server Started on %s\n", ":8080")
+	ctx := context.Background()
+	if err := migrator.Check(ctx); err != nil {
+		if !errors.Is(err, migrations.ErrSchemaBehind) {
+			drv.Close()
+			return nil, err
+		}
+
+		if !cfg.autoMigrate {
+			drv.Close()
+			return nil, fmt.Errorf("%w, run `iam migrate up` or use -auto-migrate", err)
+		}
 
-			return srv.ListenAndServe()
-		}, func(err error) {
-			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied migration %d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			drv.Close()
+			return nil, err
+		}
+	}
+
+	return ent.NewClient(ent.Driver(drv)), nil
+}
 
-			if err := srv.Shutdown(ctx); err != nil {
-				fmt.Printf("Failed to shutdown server: %v\n", err)
-			}
-		})
+// customParents returns the parents whose custom roles are synced
+// for the organization id and comma separated project ids.
+func customParents(organization, projects string) []string {
+	var parents []string
+	if organization != "" {
+		parents = append(parents, "organizations/"+organization)
 	}
-	{
-		g.Add(func() error {
-			update := func() error {
-				ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-				defer cancel()
-				return application.Commands.UpdateRoles.Handle(ctx, command.UpdateRoles{})
-			}
-
-			for {
-				if err := update(); err != nil {
-					return err
-				}
-				time.Sleep(time.Minute * 5)
-			}
-
-		}, func(err error) {
-			fmt.Printf("failed to update roles: %v\n", err)
-		})
+	for _, project := range splitList(projects) {
+		parents = append(parents, "projects/"+project)
 	}
 
-	if err := g.Run(); err != nil {
-		fmt.Printf("The run group was terminated: %v\n", err)
+	return parents
+}
+
+// mergeParents returns the union of a and b.
+func mergeParents(a, b []string) []string {
+	seen := make(map[string]bool, len(a))
+	parents := make([]string, 0, len(a)+len(b))
+	for _, parent := range append(append([]string{}, a...), b...) {
+		if seen[parent] {
+			continue
+		}
+
+		seen[parent] = true
+		parents = append(parents, parent)
 	}
+
+	return parents
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
 }
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/rosstimothy/iam/ent"
+)
+
+// migrate manages the versioned schema migrations of the database.
+func migrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	flags.Usage = func() {
+		out := flags.Output()
+		fmt.Fprintln(out, "usage: iam migrate [flags] COMMAND")
+		fmt.Fprintln(out, "")
+		fmt.Fprintln(out, "commands:")
+		fmt.Fprintln(out, "  up        apply all pending migrations")
+		fmt.Fprintln(out, "  down [N]  revert the N most recent migrations, 1 by default")
+		fmt.Fprintln(out, "  status    list migrations and whether they are applied")
+		fmt.Fprintln(out, "  diff      print the statements ent/schema requires on top of the current schema")
+		fmt.Fprintln(out, "")
+		flags.PrintDefaults()
+	}
+	db := addDatabaseFlags(flags)
+	timeout := flags.Duration("timeout", 5*time.Minute, "maximum duration of the migration")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	drv, migrator, err := openDB(db)
+	if err != nil {
+		return err
+	}
+	defer drv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	switch flags.Arg(0) {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied migration %d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+	case "down":
+		n := 1
+		if flags.NArg() > 1 {
+			n, err = strconv.Atoi(flags.Arg(1))
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid number of migrations %q", flags.Arg(1))
+			}
+		}
+
+		reverted, err := migrator.Down(ctx, n)
+		for _, m := range reverted {
+			fmt.Printf("reverted migration %d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+	case "status":
+		version, err := migrator.Version(ctx)
+		if err != nil {
+			return err
+		}
+
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range status {
+			applied := "pending"
+			if s.Applied {
+				applied = "applied " + s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%04d_%s\t%s\n", s.Version, s.Name, applied)
+		}
+
+		fmt.Printf("db is at version %d, latest version is %d\n", version, migrator.Latest())
+	case "diff":
+		// the diff is only meaningful against the latest migration
+		if err := migrator.Check(ctx); err != nil {
+			return err
+		}
+
+		client := ent.NewClient(ent.Driver(drv))
+		return client.Schema.WriteTo(ctx, os.Stdout)
+	default:
+		return fmt.Errorf("unknown migrate command %q", flags.Arg(0))
+	}
+
+	return nil
+}
@@ -0,0 +1,351 @@
+// Package migrations manages the versioned schema migrations of the db
+// described by ent/schema.
+//
+// Each dialect has its own directory of migrations named
+// <version>_<name>.up.sql and <version>_<name>.down.sql. Statements
+// within a migration must be terminated by a semicolon at the end of a line.
+// New migrations can be drafted from the changes ent would apply to an
+// existing db with `iam migrate diff`.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+//go:embed sqlite3/*.sql postgres/*.sql mysql/*.sql
+var files embed.FS
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// ErrSchemaAhead is returned by Check when the db has migrations applied
+// which are unknown to this binary.
+var ErrSchemaAhead = errors.New("db schema is newer than this binary supports")
+
+// ErrSchemaBehind is returned by Check when the db has pending migrations.
+var ErrSchemaBehind = errors.New("db schema has pending migrations")
+
+type Migration struct {
+	Version int
+	Name    string
+	up      string
+	down    string
+}
+
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db         *sql.DB
+	dialect    string
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, dialectName string) (*Migrator, error) {
+	if db == nil {
+		panic("nil db")
+	}
+
+	migrations, err := load(dialectName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, dialect: dialectName, migrations: migrations}, nil
+}
+
+func load(dialectName string) ([]Migration, error) {
+	switch dialectName {
+	case dialect.SQLite, dialect.Postgres, dialect.MySQL:
+	default:
+		return nil, fmt.Errorf("no migrations for dialect %q", dialectName)
+	}
+
+	entries, err := fs.ReadDir(files, dialectName)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %s", entry.Name())
+		}
+
+		version, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := files.ReadFile(path.Join(dialectName, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has conflicting names %s and %s", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.up = string(data)
+		} else {
+			m.down = string(data)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up migration", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			return nil, fmt.Errorf("missing migration %d", i+1)
+		}
+	}
+
+	return migrations, nil
+}
+
+// Latest returns the version of the most recent migration known to this binary.
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the version of the most recently applied migration.
+func (m *Migrator) Version(ctx context.Context) (int, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return 0, err
+	}
+
+	var version sql.NullInt64
+	if err := m.db.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_migrations").Scan(&version); err != nil {
+		return 0, err
+	}
+
+	return int(version.Int64), nil
+}
+
+// Check returns ErrSchemaAhead or ErrSchemaBehind if the db schema
+// version does not match the latest migration.
+func (m *Migrator) Check(ctx context.Context) error {
+	version, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch latest := m.Latest(); {
+	case version > latest:
+		return fmt.Errorf("%w: db is at version %d, latest known version is %d", ErrSchemaAhead, version, latest)
+	case version < latest:
+		return fmt.Errorf("%w: db is at version %d, latest version is %d", ErrSchemaBehind, version, latest)
+	}
+
+	return nil
+}
+
+// Status returns every known migration and whether it has been applied.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]Status, len(m.migrations))
+	for i, migration := range m.migrations {
+		appliedAt, ok := applied[migration.Version]
+		status[i] = Status{Migration: migration, Applied: ok, AppliedAt: appliedAt}
+	}
+
+	return status, nil
+}
+
+// Up applies all pending migrations in order and returns the applied migrations.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	version, err := m.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if version > m.Latest() {
+		return nil, fmt.Errorf("%w: db is at version %d, latest known version is %d", ErrSchemaAhead, version, m.Latest())
+	}
+
+	var applied []Migration
+	for _, migration := range m.migrations[version:] {
+		err := m.apply(ctx, migration.up, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx,
+				fmt.Sprintf("INSERT INTO schema_migrations (version, name, applied_at) VALUES (%s, %s, %s)", m.arg(1), m.arg(2), m.arg(3)),
+				migration.Version, migration.Name, time.Now().UTC())
+			return err
+		})
+		if err != nil {
+			return applied, fmt.Errorf("applying migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		applied = append(applied, migration)
+	}
+
+	return applied, nil
+}
+
+// Down reverts the n most recently applied migrations and returns the
+// reverted migrations.
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	version, err := m.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if version > m.Latest() {
+		return nil, fmt.Errorf("%w: db is at version %d, latest known version is %d", ErrSchemaAhead, version, m.Latest())
+	}
+
+	var reverted []Migration
+	for ; n > 0 && version > 0; n, version = n-1, version-1 {
+		migration := m.migrations[version-1]
+		if migration.down == "" {
+			return reverted, fmt.Errorf("migration %d_%s cannot be reverted", migration.Version, migration.Name)
+		}
+
+		err := m.apply(ctx, migration.down, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM schema_migrations WHERE version = %s", m.arg(1)), migration.Version)
+			return err
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("reverting migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		reverted = append(reverted, migration)
+	}
+
+	return reverted, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, script string, record func(tx *sql.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	for _, stmt := range statements(script) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	if err := record(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int]time.Time, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+
+	rows, err := m.db.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int]time.Time{}
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+
+	return applied, rows.Err()
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	var stmt string
+	switch m.dialect {
+	case dialect.Postgres:
+		stmt = `CREATE TABLE IF NOT EXISTS schema_migrations (version bigint PRIMARY KEY, name varchar NOT NULL, applied_at timestamp with time zone NOT NULL)`
+	case dialect.MySQL:
+		stmt = "CREATE TABLE IF NOT EXISTS schema_migrations (version bigint PRIMARY KEY, name varchar(255) NOT NULL, applied_at timestamp NOT NULL)"
+	default:
+		stmt = "CREATE TABLE IF NOT EXISTS schema_migrations (version integer PRIMARY KEY, name varchar(255) NOT NULL, applied_at datetime NOT NULL)"
+	}
+
+	_, err := m.db.ExecContext(ctx, stmt)
+	return err
+}
+
+func (m *Migrator) arg(i int) string {
+	if m.dialect == dialect.Postgres {
+		return "$" + strconv.Itoa(i)
+	}
+
+	return "?"
+}
+
+// statements splits a migration script into its individual statements
+// without their terminating semicolons.
+func statements(script string) []string {
+	var stmts []string
+	var b strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		b.WriteString(line)
+		b.WriteString("\n")
+
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSuffix(strings.TrimSpace(b.String()), ";"))
+			b.Reset()
+		}
+	}
+
+	if s := strings.TrimSpace(b.String()); s != "" {
+		stmts = append(stmts, s)
+	}
+
+	return stmts
+}
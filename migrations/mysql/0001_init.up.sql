@@ -0,0 +1,3 @@
+CREATE TABLE IF NOT EXISTS `permissions`(`id` bigint AUTO_INCREMENT NOT NULL, `name` varchar(255) UNIQUE NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE TABLE IF NOT EXISTS `roles`(`id` bigint AUTO_INCREMENT NOT NULL, `name` varchar(255) UNIQUE NOT NULL, `title` varchar(255) NOT NULL, `description` varchar(255) NOT NULL, `stage` bigint NOT NULL, `etag` blob NOT NULL, `scope` enum('predefined', 'organization', 'project') NOT NULL DEFAULT 'predefined', `parent` varchar(255) NOT NULL DEFAULT '', PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE TABLE IF NOT EXISTS `role_permissions`(`role_id` bigint NOT NULL, `permission_id` bigint NOT NULL, PRIMARY KEY(`role_id`, `permission_id`), CONSTRAINT `role_permissions_role_id` FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE, CONSTRAINT `role_permissions_permission_id` FOREIGN KEY(`permission_id`) REFERENCES `permissions`(`id`) ON DELETE CASCADE) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
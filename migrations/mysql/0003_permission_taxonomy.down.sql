@@ -0,0 +1,4 @@
+ALTER TABLE `permissions` DROP FOREIGN KEY `permissions_resource_types_permissions`, DROP FOREIGN KEY `permissions_services_permissions`;
+ALTER TABLE `permissions` DROP COLUMN `verb`, DROP COLUMN `resource_type_permissions`, DROP COLUMN `service_permissions`;
+DROP TABLE `resource_types`;
+DROP TABLE `services`;
@@ -0,0 +1,3 @@
+ALTER TABLE `roles` ADD COLUMN `stage_number` bigint NOT NULL AFTER `stage`;
+UPDATE `roles` SET `stage_number` = CASE `stage` WHEN 'BETA' THEN 1 WHEN 'GA' THEN 2 WHEN 'DEPRECATED' THEN 4 WHEN 'DISABLED' THEN 5 WHEN 'EAP' THEN 6 ELSE 0 END;
+ALTER TABLE `roles` DROP COLUMN `stage`, CHANGE COLUMN `stage_number` `stage` bigint NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE `roles` ADD COLUMN `stage_name` enum('ALPHA', 'BETA', 'GA', 'DEPRECATED', 'DISABLED', 'EAP') NOT NULL AFTER `stage`;
+UPDATE `roles` SET `stage_name` = CASE `stage` WHEN 1 THEN 'BETA' WHEN 2 THEN 'GA' WHEN 4 THEN 'DEPRECATED' WHEN 5 THEN 'DISABLED' WHEN 6 THEN 'EAP' ELSE 'ALPHA' END;
+ALTER TABLE `roles` DROP COLUMN `stage`, CHANGE COLUMN `stage_name` `stage` enum('ALPHA', 'BETA', 'GA', 'DEPRECATED', 'DISABLED', 'EAP') NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE `roles` ADD COLUMN `deleted_at` timestamp NULL;
@@ -0,0 +1,3 @@
+CREATE TABLE `role_revisions`(`id` bigint AUTO_INCREMENT NOT NULL, `revision` bigint NOT NULL, `title` varchar(255) NOT NULL, `description` varchar(255) NOT NULL, `stage` enum('ALPHA', 'BETA', 'GA', 'DEPRECATED', 'DISABLED', 'EAP') NOT NULL, `etag` blob NOT NULL, `permissions` json NOT NULL, `deleted` boolean NOT NULL DEFAULT false, `created_at` timestamp NULL, `role_revisions` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE UNIQUE INDEX `rolerevision_revision_role_revisions` ON `role_revisions`(`revision`, `role_revisions`);
+ALTER TABLE `role_revisions` ADD CONSTRAINT `role_revisions_roles_revisions` FOREIGN KEY(`role_revisions`) REFERENCES `roles`(`id`) ON DELETE SET NULL;
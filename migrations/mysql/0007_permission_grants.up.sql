@@ -0,0 +1,4 @@
+CREATE TABLE `permission_grants`(`id` bigint AUTO_INCREMENT NOT NULL, `valid_from` timestamp NULL, `valid_to` timestamp NULL, `permission_grants` bigint NULL, `role_grants` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `permissiongrant_valid_from_role_grants` ON `permission_grants`(`valid_from`, `role_grants`);
+CREATE INDEX `permissiongrant_valid_from_permission_grants` ON `permission_grants`(`valid_from`, `permission_grants`);
+ALTER TABLE `permission_grants` ADD CONSTRAINT `permission_grants_permissions_grants` FOREIGN KEY(`permission_grants`) REFERENCES `permissions`(`id`) ON DELETE SET NULL, ADD CONSTRAINT `permission_grants_roles_grants` FOREIGN KEY(`role_grants`) REFERENCES `roles`(`id`) ON DELETE SET NULL;
@@ -0,0 +1,4 @@
+CREATE TABLE `change_sets`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` timestamp NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `changeset_created_at` ON `change_sets`(`created_at`);
+CREATE TABLE `role_changes`(`id` bigint AUTO_INCREMENT NOT NULL, `role` varchar(255) NOT NULL, `kind` enum('added', 'deleted', 'undeleted', 'updated') NOT NULL, `title` varchar(255) NOT NULL, `previous_title` varchar(255) NULL, `stage` enum('ALPHA', 'BETA', 'GA', 'DEPRECATED', 'DISABLED', 'EAP') NOT NULL, `previous_stage` enum('ALPHA', 'BETA', 'GA', 'DEPRECATED', 'DISABLED', 'EAP') NULL, `permissions_added` json NULL, `permissions_removed` json NULL, `change_set_changes` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+ALTER TABLE `role_changes` ADD CONSTRAINT `role_changes_change_sets_changes` FOREIGN KEY(`change_set_changes`) REFERENCES `change_sets`(`id`) ON DELETE SET NULL;
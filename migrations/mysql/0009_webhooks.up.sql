@@ -0,0 +1,4 @@
+CREATE TABLE `webhooks`(`id` bigint AUTO_INCREMENT NOT NULL, `url` varchar(255) NOT NULL, `secret` varchar(255) NOT NULL, `role_patterns` json NULL, `permission_patterns` json NULL, `kinds` json NULL, `created_at` timestamp NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE TABLE `webhook_deliveries`(`id` bigint AUTO_INCREMENT NOT NULL, `status` enum('pending', 'succeeded', 'failed') NOT NULL DEFAULT 'pending', `attempts` bigint NOT NULL DEFAULT 0, `next_attempt_at` timestamp NULL, `response_code` bigint NULL, `error` varchar(255) NULL, `created_at` timestamp NULL, `delivered_at` timestamp NULL, `change_set_deliveries` bigint NULL, `webhook_deliveries` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `webhookdelivery_status_next_attempt_at` ON `webhook_deliveries`(`status`, `next_attempt_at`);
+ALTER TABLE `webhook_deliveries` ADD CONSTRAINT `webhook_deliveries_change_sets_deliveries` FOREIGN KEY(`change_set_deliveries`) REFERENCES `change_sets`(`id`) ON DELETE SET NULL, ADD CONSTRAINT `webhook_deliveries_webhooks_deliveries` FOREIGN KEY(`webhook_deliveries`) REFERENCES `webhooks`(`id`) ON DELETE SET NULL;
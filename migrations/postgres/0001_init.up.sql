@@ -0,0 +1,3 @@
+CREATE TABLE IF NOT EXISTS "permissions"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "name" varchar UNIQUE NOT NULL, PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "roles"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "name" varchar UNIQUE NOT NULL, "title" varchar NOT NULL, "description" varchar NOT NULL, "stage" bigint NOT NULL, "etag" bytea NOT NULL, "scope" varchar NOT NULL DEFAULT 'predefined', "parent" varchar NOT NULL DEFAULT '', PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "role_permissions"("role_id" bigint NOT NULL, "permission_id" bigint NOT NULL, PRIMARY KEY("role_id", "permission_id"), CONSTRAINT "role_permissions_role_id" FOREIGN KEY("role_id") REFERENCES "roles"("id") ON DELETE CASCADE, CONSTRAINT "role_permissions_permission_id" FOREIGN KEY("permission_id") REFERENCES "permissions"("id") ON DELETE CASCADE);
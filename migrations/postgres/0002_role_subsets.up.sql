@@ -0,0 +1 @@
+CREATE TABLE "role_subsets"("role_id" bigint NOT NULL, "superset_id" bigint NOT NULL, PRIMARY KEY("role_id", "superset_id"), CONSTRAINT "role_subsets_role_id" FOREIGN KEY("role_id") REFERENCES "roles"("id") ON DELETE CASCADE, CONSTRAINT "role_subsets_superset_id" FOREIGN KEY("superset_id") REFERENCES "roles"("id") ON DELETE CASCADE);
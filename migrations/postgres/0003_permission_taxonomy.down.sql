@@ -0,0 +1,3 @@
+ALTER TABLE "permissions" DROP COLUMN "verb", DROP COLUMN "resource_type_permissions", DROP COLUMN "service_permissions";
+DROP TABLE "resource_types";
+DROP TABLE "services";
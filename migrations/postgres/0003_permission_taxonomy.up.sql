@@ -0,0 +1,6 @@
+CREATE TABLE "services"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "name" varchar UNIQUE NOT NULL, PRIMARY KEY("id"));
+CREATE TABLE "resource_types"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "name" varchar NOT NULL, "service_resource_types" bigint NULL, PRIMARY KEY("id"));
+CREATE UNIQUE INDEX "resourcetype_name_service_resource_types" ON "resource_types"("name", "service_resource_types");
+ALTER TABLE "resource_types" ADD CONSTRAINT "resource_types_services_resource_types" FOREIGN KEY("service_resource_types") REFERENCES "services"("id") ON DELETE SET NULL;
+ALTER TABLE "permissions" ADD COLUMN "verb" varchar NOT NULL DEFAULT '', ADD COLUMN "resource_type_permissions" bigint NULL, ADD COLUMN "service_permissions" bigint NULL;
+ALTER TABLE "permissions" ADD CONSTRAINT "permissions_resource_types_permissions" FOREIGN KEY("resource_type_permissions") REFERENCES "resource_types"("id") ON DELETE SET NULL, ADD CONSTRAINT "permissions_services_permissions" FOREIGN KEY("service_permissions") REFERENCES "services"("id") ON DELETE SET NULL;
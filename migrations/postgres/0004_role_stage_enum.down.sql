@@ -0,0 +1 @@
+ALTER TABLE "roles" ALTER COLUMN "stage" TYPE bigint USING CASE "stage" WHEN 'BETA' THEN 1 WHEN 'GA' THEN 2 WHEN 'DEPRECATED' THEN 4 WHEN 'DISABLED' THEN 5 WHEN 'EAP' THEN 6 ELSE 0 END;
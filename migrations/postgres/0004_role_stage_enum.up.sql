@@ -0,0 +1 @@
+ALTER TABLE "roles" ALTER COLUMN "stage" TYPE varchar USING CASE "stage" WHEN 1 THEN 'BETA' WHEN 2 THEN 'GA' WHEN 4 THEN 'DEPRECATED' WHEN 5 THEN 'DISABLED' WHEN 6 THEN 'EAP' ELSE 'ALPHA' END;
@@ -0,0 +1,3 @@
+CREATE TABLE "role_revisions"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "revision" bigint NOT NULL, "title" varchar NOT NULL, "description" varchar NOT NULL, "stage" varchar NOT NULL, "etag" bytea NOT NULL, "permissions" jsonb NOT NULL, "deleted" boolean NOT NULL DEFAULT false, "created_at" timestamp with time zone NOT NULL, "role_revisions" bigint NULL, PRIMARY KEY("id"));
+CREATE UNIQUE INDEX "rolerevision_revision_role_revisions" ON "role_revisions"("revision", "role_revisions");
+ALTER TABLE "role_revisions" ADD CONSTRAINT "role_revisions_roles_revisions" FOREIGN KEY("role_revisions") REFERENCES "roles"("id") ON DELETE SET NULL;
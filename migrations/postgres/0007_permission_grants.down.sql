@@ -0,0 +1 @@
+DROP TABLE "permission_grants";
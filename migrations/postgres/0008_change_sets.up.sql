@@ -0,0 +1,4 @@
+CREATE TABLE "change_sets"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, PRIMARY KEY("id"));
+CREATE INDEX "changeset_created_at" ON "change_sets"("created_at");
+CREATE TABLE "role_changes"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "role" varchar NOT NULL, "kind" varchar NOT NULL, "title" varchar NOT NULL, "previous_title" varchar NULL, "stage" varchar NOT NULL, "previous_stage" varchar NULL, "permissions_added" jsonb NULL, "permissions_removed" jsonb NULL, "change_set_changes" bigint NULL, PRIMARY KEY("id"));
+ALTER TABLE "role_changes" ADD CONSTRAINT "role_changes_change_sets_changes" FOREIGN KEY("change_set_changes") REFERENCES "change_sets"("id") ON DELETE SET NULL;
@@ -0,0 +1,4 @@
+CREATE TABLE "webhooks"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "url" varchar NOT NULL, "secret" varchar NOT NULL, "role_patterns" jsonb NULL, "permission_patterns" jsonb NULL, "kinds" jsonb NULL, "created_at" timestamp with time zone NOT NULL, PRIMARY KEY("id"));
+CREATE TABLE "webhook_deliveries"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "status" varchar NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "next_attempt_at" timestamp with time zone NOT NULL, "response_code" bigint NULL, "error" varchar NULL, "created_at" timestamp with time zone NOT NULL, "delivered_at" timestamp with time zone NULL, "change_set_deliveries" bigint NULL, "webhook_deliveries" bigint NULL, PRIMARY KEY("id"));
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries"("status", "next_attempt_at");
+ALTER TABLE "webhook_deliveries" ADD CONSTRAINT "webhook_deliveries_change_sets_deliveries" FOREIGN KEY("change_set_deliveries") REFERENCES "change_sets"("id") ON DELETE SET NULL, ADD CONSTRAINT "webhook_deliveries_webhooks_deliveries" FOREIGN KEY("webhook_deliveries") REFERENCES "webhooks"("id") ON DELETE SET NULL;
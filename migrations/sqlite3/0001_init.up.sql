@@ -0,0 +1,3 @@
+CREATE TABLE IF NOT EXISTS `permissions`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `name` varchar(255) UNIQUE NOT NULL);
+CREATE TABLE IF NOT EXISTS `roles`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `name` varchar(255) UNIQUE NOT NULL, `title` varchar(255) NOT NULL, `description` varchar(255) NOT NULL, `stage` integer NOT NULL, `etag` blob NOT NULL, `scope` varchar(255) NOT NULL DEFAULT 'predefined', `parent` varchar(255) NOT NULL DEFAULT '');
+CREATE TABLE IF NOT EXISTS `role_permissions`(`role_id` integer NOT NULL, `permission_id` integer NOT NULL, PRIMARY KEY(`role_id`, `permission_id`), FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE, FOREIGN KEY(`permission_id`) REFERENCES `permissions`(`id`) ON DELETE CASCADE);
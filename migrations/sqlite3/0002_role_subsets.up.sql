@@ -0,0 +1 @@
+CREATE TABLE `role_subsets`(`role_id` integer NOT NULL, `superset_id` integer NOT NULL, PRIMARY KEY(`role_id`, `superset_id`), FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE, FOREIGN KEY(`superset_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE);
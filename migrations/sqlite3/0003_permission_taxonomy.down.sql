@@ -0,0 +1,15 @@
+-- sqlite can't drop columns with foreign keys, so permissions is rebuilt.
+-- role_permissions is set aside first since dropping permissions would
+-- cascade to it.
+CREATE TABLE `role_permissions_backup` AS SELECT `role_id`, `permission_id` FROM `role_permissions`;
+DROP TABLE `role_permissions`;
+CREATE TABLE `permissions_backup` AS SELECT `id`, `name` FROM `permissions`;
+DROP TABLE `permissions`;
+CREATE TABLE `permissions`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `name` varchar(255) UNIQUE NOT NULL);
+INSERT INTO `permissions`(`id`, `name`) SELECT `id`, `name` FROM `permissions_backup`;
+DROP TABLE `permissions_backup`;
+CREATE TABLE `role_permissions`(`role_id` integer NOT NULL, `permission_id` integer NOT NULL, PRIMARY KEY(`role_id`, `permission_id`), FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE, FOREIGN KEY(`permission_id`) REFERENCES `permissions`(`id`) ON DELETE CASCADE);
+INSERT INTO `role_permissions`(`role_id`, `permission_id`) SELECT `role_id`, `permission_id` FROM `role_permissions_backup`;
+DROP TABLE `role_permissions_backup`;
+DROP TABLE `resource_types`;
+DROP TABLE `services`;
@@ -0,0 +1,6 @@
+CREATE TABLE `services`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `name` varchar(255) UNIQUE NOT NULL);
+CREATE TABLE `resource_types`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `name` varchar(255) NOT NULL, `service_resource_types` integer NULL, FOREIGN KEY(`service_resource_types`) REFERENCES `services`(`id`) ON DELETE SET NULL);
+CREATE UNIQUE INDEX `resourcetype_name_service_resource_types` ON `resource_types`(`name`, `service_resource_types`);
+ALTER TABLE `permissions` ADD COLUMN `verb` varchar(255) NOT NULL DEFAULT '';
+ALTER TABLE `permissions` ADD COLUMN `resource_type_permissions` integer NULL CONSTRAINT permissions_resource_types_permissions REFERENCES `resource_types`(`id`) ON DELETE SET NULL;
+ALTER TABLE `permissions` ADD COLUMN `service_permissions` integer NULL CONSTRAINT permissions_services_permissions REFERENCES `services`(`id`) ON DELETE SET NULL;
@@ -0,0 +1,18 @@
+-- sqlite can't change the type of a column, so roles is rebuilt. The tables
+-- referencing roles are set aside first since dropping roles would cascade
+-- to them.
+CREATE TABLE `role_permissions_backup` AS SELECT `role_id`, `permission_id` FROM `role_permissions`;
+CREATE TABLE `role_subsets_backup` AS SELECT `role_id`, `superset_id` FROM `role_subsets`;
+DROP TABLE `role_permissions`;
+DROP TABLE `role_subsets`;
+CREATE TABLE `roles_backup` AS SELECT * FROM `roles`;
+DROP TABLE `roles`;
+CREATE TABLE `roles`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `name` varchar(255) UNIQUE NOT NULL, `title` varchar(255) NOT NULL, `description` varchar(255) NOT NULL, `stage` varchar(255) NOT NULL, `etag` blob NOT NULL, `scope` varchar(255) NOT NULL DEFAULT 'predefined', `parent` varchar(255) NOT NULL DEFAULT '');
+INSERT INTO `roles`(`id`, `name`, `title`, `description`, `stage`, `etag`, `scope`, `parent`) SELECT `id`, `name`, `title`, `description`, CASE `stage` WHEN 1 THEN 'BETA' WHEN 2 THEN 'GA' WHEN 4 THEN 'DEPRECATED' WHEN 5 THEN 'DISABLED' WHEN 6 THEN 'EAP' ELSE 'ALPHA' END, `etag`, `scope`, `parent` FROM `roles_backup`;
+DROP TABLE `roles_backup`;
+CREATE TABLE `role_permissions`(`role_id` integer NOT NULL, `permission_id` integer NOT NULL, PRIMARY KEY(`role_id`, `permission_id`), FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE, FOREIGN KEY(`permission_id`) REFERENCES `permissions`(`id`) ON DELETE CASCADE);
+CREATE TABLE `role_subsets`(`role_id` integer NOT NULL, `superset_id` integer NOT NULL, PRIMARY KEY(`role_id`, `superset_id`), FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE, FOREIGN KEY(`superset_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE);
+INSERT INTO `role_permissions`(`role_id`, `permission_id`) SELECT `role_id`, `permission_id` FROM `role_permissions_backup`;
+INSERT INTO `role_subsets`(`role_id`, `superset_id`) SELECT `role_id`, `superset_id` FROM `role_subsets_backup`;
+DROP TABLE `role_permissions_backup`;
+DROP TABLE `role_subsets_backup`;
@@ -0,0 +1,2 @@
+CREATE TABLE `role_revisions`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `revision` integer NOT NULL, `title` varchar(255) NOT NULL, `description` varchar(255) NOT NULL, `stage` varchar(255) NOT NULL, `etag` blob NOT NULL, `permissions` json NOT NULL, `deleted` bool NOT NULL DEFAULT false, `created_at` datetime NOT NULL, `role_revisions` integer NULL, FOREIGN KEY(`role_revisions`) REFERENCES `roles`(`id`) ON DELETE SET NULL);
+CREATE UNIQUE INDEX `rolerevision_revision_role_revisions` ON `role_revisions`(`revision`, `role_revisions`);
@@ -0,0 +1,3 @@
+CREATE TABLE `permission_grants`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `valid_from` datetime NOT NULL, `valid_to` datetime NULL, `permission_grants` integer NULL, `role_grants` integer NULL, FOREIGN KEY(`permission_grants`) REFERENCES `permissions`(`id`) ON DELETE SET NULL, FOREIGN KEY(`role_grants`) REFERENCES `roles`(`id`) ON DELETE SET NULL);
+CREATE INDEX `permissiongrant_valid_from_role_grants` ON `permission_grants`(`valid_from`, `role_grants`);
+CREATE INDEX `permissiongrant_valid_from_permission_grants` ON `permission_grants`(`valid_from`, `permission_grants`);
@@ -0,0 +1,3 @@
+CREATE TABLE `change_sets`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL);
+CREATE INDEX `changeset_created_at` ON `change_sets`(`created_at`);
+CREATE TABLE `role_changes`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `role` varchar(255) NOT NULL, `kind` varchar(255) NOT NULL, `title` varchar(255) NOT NULL, `previous_title` varchar(255) NULL, `stage` varchar(255) NOT NULL, `previous_stage` varchar(255) NULL, `permissions_added` json NULL, `permissions_removed` json NULL, `change_set_changes` integer NULL, FOREIGN KEY(`change_set_changes`) REFERENCES `change_sets`(`id`) ON DELETE SET NULL);
@@ -0,0 +1,3 @@
+CREATE TABLE `webhooks`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `url` varchar(255) NOT NULL, `secret` varchar(255) NOT NULL, `role_patterns` json NULL, `permission_patterns` json NULL, `kinds` json NULL, `created_at` datetime NOT NULL);
+CREATE TABLE `webhook_deliveries`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `status` varchar(255) NOT NULL DEFAULT 'pending', `attempts` integer NOT NULL DEFAULT 0, `next_attempt_at` datetime NOT NULL, `response_code` integer NULL, `error` varchar(255) NULL, `created_at` datetime NOT NULL, `delivered_at` datetime NULL, `change_set_deliveries` integer NULL, `webhook_deliveries` integer NULL, FOREIGN KEY(`change_set_deliveries`) REFERENCES `change_sets`(`id`) ON DELETE SET NULL, FOREIGN KEY(`webhook_deliveries`) REFERENCES `webhooks`(`id`) ON DELETE SET NULL);
+CREATE INDEX `webhookdelivery_status_next_attempt_at` ON `webhook_deliveries`(`status`, `next_attempt_at`);
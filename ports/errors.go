@@ -0,0 +1,66 @@
+package ports
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+
+	"github.com/rosstimothy/iam/app/apperr"
+)
+
+type errorResponse struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+var errorStatus = map[apperr.Kind]int{
+	apperr.KindInvalidArgument: http.StatusBadRequest,
+	apperr.KindNotFound:        http.StatusNotFound,
+	apperr.KindConflict:        http.StatusConflict,
+	apperr.KindUnavailable:     http.StatusServiceUnavailable,
+}
+
+// respondWithError writes err as a JSON error with the status code of its
+// kind. Errors which are not an *apperr.Error are reported as internal errors
+// without exposing their message.
+func respondWithError(w http.ResponseWriter, r *http.Request, err error) {
+	fmt.Println(err)
+
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) {
+		writeError(w, r, http.StatusInternalServerError, "internal", "internal error")
+		return
+	}
+
+	status, ok := errorStatus[appErr.Kind]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+
+	writeError(w, r, status, string(appErr.Kind), appErr.Message)
+}
+
+func badRequest(w http.ResponseWriter, r *http.Request, format string, args ...interface{}) {
+	writeError(w, r, http.StatusBadRequest, string(apperr.KindInvalidArgument), fmt.Sprintf(format, args...))
+}
+
+func notFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, http.StatusNotFound, string(apperr.KindNotFound), fmt.Sprintf("%s not found", r.URL.Path))
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errorResponse{Error: errorBody{
+		Code:      code,
+		Message:   message,
+		RequestID: middleware.GetReqID(r.Context()),
+	}})
+}
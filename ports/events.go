@@ -0,0 +1,144 @@
+package ports
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rosstimothy/iam/app/query"
+)
+
+// eventPollInterval is the interval at which event streams look for changes
+// committed without being published, e.g. by `iam import`, and keep idle
+// connections alive.
+const eventPollInterval = 30 * time.Second
+
+// ChangeBroker notifies the event streams of the changes committed by the
+// syncs. The streams read the changes themselves, so that resuming a stream
+// and streaming new changes are the same.
+type ChangeBroker struct {
+	mu          sync.Mutex
+	subscribers map[chan struct{}]bool
+}
+
+func NewChangeBroker() *ChangeBroker {
+	return &ChangeBroker{subscribers: map[chan struct{}]bool{}}
+}
+
+// Publish notifies every subscriber that new changes were committed.
+func (b *ChangeBroker) Publish() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		// a pending notification covers this one as well
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (b *ChangeBroker) subscribe() chan struct{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+	b.subscribers[ch] = true
+
+	return ch
+}
+
+func (b *ChangeBroker) unsubscribe(ch chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subscribers, ch)
+}
+
+// Events streams the changes made by the syncs as server-sent events, one
+// event per change named after the kind of the change with the id of the
+// change as event id. Streams start after the latest change, or after the
+// change identified by the Last-Event-ID header to resume a stream.
+func (h *HttpServer) Events() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			writeError(w, r, http.StatusInternalServerError, "internal", "streaming is not supported")
+			return
+		}
+
+		var last int
+		if v := r.Header.Get("Last-Event-ID"); v != "" {
+			var err error
+			if last, err = strconv.Atoi(v); err != nil || last < 0 {
+				badRequest(w, r, "invalid Last-Event-ID %q", v)
+				return
+			}
+		} else {
+			var err error
+			if last, err = h.app.Queries.LastChangeID.Handle(r.Context(), query.LastChangeID{}); err != nil {
+				respondWithError(w, r, err)
+				return
+			}
+		}
+
+		notifications := h.broker.subscribe()
+		defer h.broker.unsubscribe(notifications)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		// send writes the changes after last
+		send := func() error {
+			for {
+				page, err := h.app.Queries.ListChanges.Handle(r.Context(), query.ListChanges{After: last, PageSize: 1000})
+				if err != nil {
+					return err
+				}
+
+				for _, c := range page.Changes {
+					data, err := json.Marshal(c)
+					if err != nil {
+						return err
+					}
+
+					if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", c.ID, c.Kind, data); err != nil {
+						return err
+					}
+					last = c.ID
+				}
+				flusher.Flush()
+
+				if page.NextPageToken == "" {
+					return nil
+				}
+			}
+		}
+
+		ticker := time.NewTicker(eventPollInterval)
+		defer ticker.Stop()
+
+		for {
+			if err := send(); err != nil {
+				fmt.Printf("failed to stream events: %v\n", err)
+				return
+			}
+
+			select {
+			case <-r.Context().Done():
+				return
+			case <-notifications:
+			case <-ticker.C:
+				if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
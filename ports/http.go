@@ -3,27 +3,49 @@ package ports
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
 
 	"github.com/rosstimothy/iam/app"
+	"github.com/rosstimothy/iam/app/command"
 	"github.com/rosstimothy/iam/app/query"
 )
 
 type HttpServer struct {
-	app *app.Application
+	app    *app.Application
+	broker *ChangeBroker
 }
 
-func NewHttpServer(app *app.Application) *HttpServer {
+func NewHttpServer(app *app.Application, broker *ChangeBroker) *HttpServer {
 	if app == nil {
 		panic("nil app")
 	}
 
-	return &HttpServer{app: app}
+	if broker == nil {
+		panic("nil broker")
+	}
+
+	return &HttpServer{app: app, broker: broker}
 }
 
 func (h *HttpServer) RolesWithPermissions() http.HandlerFunc {
 	type request struct {
-		Permissions []string `json:"permissions"`
+		Permissions    []string `json:"permissions"`
+		Match          string   `json:"match"`
+		Scope          string   `json:"scope"`
+		Parent         string   `json:"parent"`
+		Stages         []string `json:"stages"`
+		ExcludeStages  []string `json:"exclude_stages"`
+		IncludeDeleted bool     `json:"include_deleted"`
+		AsOf           string   `json:"as_of"`
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -31,15 +53,40 @@ func (h *HttpServer) RolesWithPermissions() http.HandlerFunc {
 
 		var req request
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "", http.StatusBadRequest)
+			badRequest(w, r, "invalid request body: %v", err)
+			return
+		}
+
+		// v1 has always matched roles with any of the permissions
+		match := query.MatchAny
+		switch query.Match(req.Match) {
+		case "", query.MatchAny:
+		case query.MatchAll:
+			match = query.MatchAll
+		default:
+			badRequest(w, r, "invalid match %q", req.Match)
 			return
 		}
 
-		cmd := query.RolesWithPermissions{Permissions: req.Permissions}
+		asOf, ok := parseTime(req.AsOf)
+		if !ok {
+			badRequest(w, r, "invalid as_of %q: must be an RFC 3339 time or a date", req.AsOf)
+			return
+		}
+
+		cmd := query.RolesWithPermissions{
+			Permissions:    req.Permissions,
+			Match:          match,
+			Scope:          req.Scope,
+			Parent:         req.Parent,
+			Stages:         req.Stages,
+			ExcludeStages:  req.ExcludeStages,
+			IncludeDeleted: req.IncludeDeleted,
+			AsOf:           asOf,
+		}
 		roles, err := h.app.Queries.RolesWithPermissions.Handle(r.Context(), cmd)
 		if err != nil {
-			fmt.Println(err)
-			http.Error(w, "", http.StatusInternalServerError)
+			respondWithError(w, r, err)
 			return
 		}
 
@@ -49,7 +96,9 @@ func (h *HttpServer) RolesWithPermissions() http.HandlerFunc {
 
 func (h *HttpServer) RoleByName() http.HandlerFunc {
 	type request struct {
-		Name string `json:"name"`
+		Name           string `json:"name"`
+		IncludeDeleted bool   `json:"include_deleted"`
+		AsOf           string `json:"as_of"`
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -57,18 +106,675 @@ func (h *HttpServer) RoleByName() http.HandlerFunc {
 
 		var req request
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "", http.StatusBadRequest)
+			badRequest(w, r, "invalid request body: %v", err)
 			return
 		}
 
-		cmd := query.RoleByName{Role: req.Name}
+		asOf, ok := parseTime(req.AsOf)
+		if !ok {
+			badRequest(w, r, "invalid as_of %q: must be an RFC 3339 time or a date", req.AsOf)
+			return
+		}
+
+		cmd := query.RoleByName{Role: req.Name, IncludeDeleted: req.IncludeDeleted, AsOf: asOf}
 		role, err := h.app.Queries.RoleByName.Handle(r.Context(), cmd)
 		if err != nil {
-			fmt.Println(err)
-			http.Error(w, "", http.StatusInternalServerError)
+			respondWithError(w, r, err)
 			return
 		}
 
 		json.NewEncoder(w).Encode(role)
 	}
 }
+
+func (h *HttpServer) LeastPrivilegeRoles() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ranked, ok := h.rankRoles(w, r)
+		if !ok {
+			return
+		}
+
+		json.NewEncoder(w).Encode(ranked.Roles)
+	}
+}
+
+// RankedRoles is LeastPrivilegeRoles responding with the ranked
+// roles and the expansion of the requested permission patterns.
+func (h *HttpServer) RankedRoles() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ranked, ok := h.rankRoles(w, r)
+		if !ok {
+			return
+		}
+
+		json.NewEncoder(w).Encode(ranked)
+	}
+}
+
+func (h *HttpServer) rankRoles(w http.ResponseWriter, r *http.Request) (*query.RankedRoles, bool) {
+	params := r.URL.Query()
+
+	var includeExtra bool
+	if extra := params.Get("extra"); extra != "" {
+		var err error
+		includeExtra, err = strconv.ParseBool(extra)
+		if err != nil {
+			badRequest(w, r, "invalid extra %q", extra)
+			return nil, false
+		}
+	}
+
+	if len(params["permission"]) == 0 {
+		badRequest(w, r, "missing permission")
+		return nil, false
+	}
+
+	cmd := query.LeastPrivilegeRoles{
+		Permissions:  params["permission"],
+		IncludeExtra: includeExtra,
+		Scope:        params.Get("scope"),
+		Parent:       params.Get("parent"),
+		Service:      params.Get("service"),
+	}
+	cmd.Stages, cmd.ExcludeStages = stageParams(params)
+
+	var ok bool
+	if cmd.IncludeDeleted, ok = includeDeleted(w, r); !ok {
+		return nil, false
+	}
+
+	ranked, err := h.app.Queries.LeastPrivilegeRoles.Handle(r.Context(), cmd)
+	if err != nil {
+		respondWithError(w, r, err)
+		return nil, false
+	}
+
+	return ranked, true
+}
+
+func (h *HttpServer) MinimalRoleCombination() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+
+		if len(params["permission"]) == 0 {
+			badRequest(w, r, "missing permission")
+			return
+		}
+
+		cmd := query.MinimalRoleCombination{
+			Permissions: params["permission"],
+			Scope:       params.Get("scope"),
+			Parent:      params.Get("parent"),
+			Service:     params.Get("service"),
+		}
+
+		cmd.Stages, cmd.ExcludeStages = stageParams(params)
+
+		if limit := params.Get("limit"); limit != "" {
+			var err error
+			cmd.Limit, err = strconv.Atoi(limit)
+			if err != nil {
+				badRequest(w, r, "invalid limit %q", limit)
+				return
+			}
+		}
+
+		if excess := params.Get("excess"); excess != "" {
+			var err error
+			cmd.IncludeExcess, err = strconv.ParseBool(excess)
+			if err != nil {
+				badRequest(w, r, "invalid excess %q", excess)
+				return
+			}
+		}
+
+		var ok bool
+		if cmd.IncludeDeleted, ok = includeDeleted(w, r); !ok {
+			return
+		}
+
+		combinations, err := h.app.Queries.MinimalRoleCombination.Handle(r.Context(), cmd)
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(combinations)
+	}
+}
+
+func (h *HttpServer) RoleDiff() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+
+		cmd := query.RoleDiff{A: params.Get("a"), B: params.Get("b")}
+		if cmd.A == "" || cmd.B == "" {
+			badRequest(w, r, "both a and b are required")
+			return
+		}
+
+		var ok bool
+		if cmd.IncludeDeleted, ok = includeDeleted(w, r); !ok {
+			return
+		}
+
+		format := params.Get("format")
+		if format != "" && format != "json" && format != "text" {
+			badRequest(w, r, "invalid format %q", format)
+			return
+		}
+
+		diff, err := h.app.Queries.RoleDiff.Handle(r.Context(), cmd)
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		if format == "text" {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			writeUnifiedDiff(w, diff)
+			return
+		}
+
+		json.NewEncoder(w).Encode(diff)
+	}
+}
+
+// stageParams returns the stage and exclude_stage parameters. The excluded
+// stages are nil if exclude_stage is not provided at all, and empty if it is
+// only provided without a value, i.e. to exclude no stages.
+func stageParams(params url.Values) (stages, excludeStages []string) {
+	stages = params["stage"]
+
+	if values, ok := params["exclude_stage"]; ok {
+		excludeStages = []string{}
+		for _, v := range values {
+			if v != "" {
+				excludeStages = append(excludeStages, v)
+			}
+		}
+	}
+
+	return stages, excludeStages
+}
+
+// includeDeleted returns the include_deleted parameter, responding
+// with an error and returning false if it is invalid.
+func includeDeleted(w http.ResponseWriter, r *http.Request) (bool, bool) {
+	v := r.URL.Query().Get("include_deleted")
+	if v == "" {
+		return false, true
+	}
+
+	include, err := strconv.ParseBool(v)
+	if err != nil {
+		badRequest(w, r, "invalid include_deleted %q", v)
+		return false, false
+	}
+
+	return include, true
+}
+
+func pageSize(w http.ResponseWriter, r *http.Request) (int, bool) {
+	v := r.URL.Query().Get("page_size")
+	if v == "" {
+		return 0, true
+	}
+
+	size, err := strconv.Atoi(v)
+	if err != nil {
+		badRequest(w, r, "invalid page_size %q", v)
+		return 0, false
+	}
+
+	return size, true
+}
+
+// parseTime parses a time parameter, either as RFC 3339 or as a date
+// which refers to midnight UTC. An empty value is nil.
+func parseTime(v string) (*time.Time, bool) {
+	if v == "" {
+		return nil, true
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return &t, true
+		}
+	}
+
+	return nil, false
+}
+
+// asOf returns the as_of parameter, responding with
+// an error and returning false if it is invalid.
+func asOf(w http.ResponseWriter, r *http.Request) (*time.Time, bool) {
+	v := r.URL.Query().Get("as_of")
+	t, ok := parseTime(v)
+	if !ok {
+		badRequest(w, r, "invalid as_of %q: must be an RFC 3339 time or a date", v)
+	}
+
+	return t, ok
+}
+
+// writeUnifiedDiff writes diff in the style of a unified diff with a
+// hunk for the differing attributes and one for the permissions.
+func writeUnifiedDiff(w io.Writer, diff *query.RoleDifference) {
+	fmt.Fprintf(w, "--- %s\n+++ %s\n", diff.A, diff.B)
+
+	for _, f := range diff.Fields {
+		fmt.Fprintf(w, "@@ %s @@\n-%s\n+%s\n", f.Field, f.A, f.B)
+	}
+
+	type line struct {
+		prefix     byte
+		permission string
+	}
+
+	lines := make([]line, 0, len(diff.Shared)+len(diff.OnlyInA)+len(diff.OnlyInB))
+	for _, p := range diff.Shared {
+		lines = append(lines, line{' ', p})
+	}
+	for _, p := range diff.OnlyInA {
+		lines = append(lines, line{'-', p})
+	}
+	for _, p := range diff.OnlyInB {
+		lines = append(lines, line{'+', p})
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].permission < lines[j].permission
+	})
+
+	fmt.Fprintf(w, "@@ permissions -%d +%d @@\n", len(diff.Shared)+len(diff.OnlyInA), len(diff.Shared)+len(diff.OnlyInB))
+	for _, l := range lines {
+		fmt.Fprintf(w, "%c%s\n", l.prefix, l.permission)
+	}
+}
+
+// roleName matches the names of predefined and custom roles,
+// i.e. roles/<role>, organizations/<id>/roles/<role> and projects/<id>/roles/<role>.
+var roleName = regexp.MustCompile(`^(roles|(organizations|projects)/[^/]+/roles)/[^/]+$`)
+
+// splitRolePath splits a path of the form <role name>[/<resource>]
+// into the role name and the requested resource of the role.
+func splitRolePath(path string) (name, resource string, ok bool) {
+	path = strings.Trim(path, "/")
+	if roleName.MatchString(path) {
+		return path, "", true
+	}
+
+	i := strings.LastIndex(path, "/")
+	if i < 0 || !roleName.MatchString(path[:i]) {
+		return "", "", false
+	}
+
+	return path[:i], path[i+1:], true
+}
+
+// RoleResource serves the resources of a role identified by its full name,
+// e.g. /roles/roles/storage.admin/subsets or /roles/roles/storage.admin/history.
+func (h *HttpServer) RoleResource() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name, resource, ok := splitRolePath(chi.URLParam(r, "*"))
+		if !ok {
+			notFound(w, r)
+			return
+		}
+
+		switch query.Relation(resource) {
+		case "":
+			h.roleByName(w, r, name)
+		case query.RelationSupersets, query.RelationSubsets:
+			h.relatedRoles(w, r, name, query.Relation(resource))
+		case "history":
+			h.roleHistory(w, r, name)
+		default:
+			notFound(w, r)
+		}
+	}
+}
+
+func (h *HttpServer) relatedRoles(w http.ResponseWriter, r *http.Request, name string, relation query.Relation) {
+	params := r.URL.Query()
+	cmd := query.RelatedRoles{Role: name, Relation: relation, Service: params.Get("service")}
+	cmd.Stages, cmd.ExcludeStages = stageParams(params)
+
+	roles, err := h.app.Queries.RelatedRoles.Handle(r.Context(), cmd)
+	if err != nil {
+		respondWithError(w, r, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(roles)
+}
+
+func (h *HttpServer) roleHistory(w http.ResponseWriter, r *http.Request, name string) {
+	cmd := query.RoleHistory{Role: name}
+	cmd.Stages, cmd.ExcludeStages = stageParams(r.URL.Query())
+
+	history, err := h.app.Queries.RoleHistory.Handle(r.Context(), cmd)
+	if err != nil {
+		respondWithError(w, r, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(history)
+}
+
+func (h *HttpServer) roleByName(w http.ResponseWriter, r *http.Request, name string) {
+	cmd := query.RoleByName{Role: name}
+
+	var ok bool
+	if cmd.IncludeDeleted, ok = includeDeleted(w, r); !ok {
+		return
+	}
+
+	if cmd.AsOf, ok = asOf(w, r); !ok {
+		return
+	}
+
+	role, err := h.app.Queries.RoleByName.Handle(r.Context(), cmd)
+	if err != nil {
+		respondWithError(w, r, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(role)
+}
+
+// Roles returns a page of roles filtered by the query parameters, e.g.
+// the roles with all permissions provided as permission parameters.
+func (h *HttpServer) Roles() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+
+		cmd := query.ListRoles{
+			Sort:        query.RoleSort(strings.TrimPrefix(params.Get("sort"), "-")),
+			Descending:  strings.HasPrefix(params.Get("sort"), "-"),
+			NamePrefix:  params.Get("name_prefix"),
+			Title:       params.Get("title"),
+			Scope:       params.Get("scope"),
+			Parent:      params.Get("parent"),
+			Service:     params.Get("service"),
+			Permissions: params["permission"],
+			Match:       query.Match(params.Get("match")),
+			PageToken:   params.Get("page_token"),
+		}
+
+		cmd.Stages, cmd.ExcludeStages = stageParams(params)
+
+		var ok bool
+		if cmd.PageSize, ok = pageSize(w, r); !ok {
+			return
+		}
+
+		if cmd.IncludeDeleted, ok = includeDeleted(w, r); !ok {
+			return
+		}
+
+		if cmd.AsOf, ok = asOf(w, r); !ok {
+			return
+		}
+
+		page, err := h.app.Queries.ListRoles.Handle(r.Context(), cmd)
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(page)
+	}
+}
+
+// Permissions returns a page of permissions filtered by the query parameters.
+func (h *HttpServer) Permissions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+
+		cmd := query.ListPermissions{
+			Prefix:    params.Get("prefix"),
+			Service:   params.Get("service"),
+			PageToken: params.Get("page_token"),
+		}
+
+		var ok bool
+		if cmd.PageSize, ok = pageSize(w, r); !ok {
+			return
+		}
+
+		page, err := h.app.Queries.ListPermissions.Handle(r.Context(), cmd)
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(page)
+	}
+}
+
+func (h *HttpServer) Permission() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cmd := query.PermissionByName{Permission: chi.URLParam(r, "name")}
+
+		var ok bool
+		if cmd.IncludeDeleted, ok = includeDeleted(w, r); !ok {
+			return
+		}
+
+		if cmd.AsOf, ok = asOf(w, r); !ok {
+			return
+		}
+
+		permission, err := h.app.Queries.PermissionByName.Handle(r.Context(), cmd)
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(permission)
+	}
+}
+
+func (h *HttpServer) Services() http.HandlerFunc {
+	type response struct {
+		Services []query.Service `json:"services"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		services, err := h.app.Queries.ListServices.Handle(r.Context(), query.ListServices{})
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(response{Services: services})
+	}
+}
+
+func (h *HttpServer) ResourceTypes() http.HandlerFunc {
+	type response struct {
+		Resources []query.ResourceType `json:"resources"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		cmd := query.ListResourceTypes{Service: chi.URLParam(r, "service")}
+		resourceTypes, err := h.app.Queries.ListResourceTypes.Handle(r.Context(), cmd)
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(response{Resources: resourceTypes})
+	}
+}
+
+func (h *HttpServer) ResourceType() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cmd := query.ResourceTypeByName{
+			Service:      chi.URLParam(r, "service"),
+			ResourceType: chi.URLParam(r, "resource"),
+		}
+		resourceType, err := h.app.Queries.ResourceTypeByName.Handle(r.Context(), cmd)
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(resourceType)
+	}
+}
+
+func (h *HttpServer) Changes() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+
+		cmd := query.ListChanges{PageToken: params.Get("page_token")}
+
+		var ok bool
+		if cmd.Since, ok = parseTime(params.Get("since")); !ok {
+			badRequest(w, r, "invalid since %q: must be an RFC 3339 time or a date", params.Get("since"))
+			return
+		}
+
+		if cmd.PageSize, ok = pageSize(w, r); !ok {
+			return
+		}
+
+		page, err := h.app.Queries.ListChanges.Handle(r.Context(), cmd)
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(page)
+	}
+}
+
+func (h *HttpServer) Webhooks() http.HandlerFunc {
+	type response struct {
+		Webhooks []query.Webhook `json:"webhooks"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		webhooks, err := h.app.Queries.ListWebhooks.Handle(r.Context(), query.ListWebhooks{})
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(response{Webhooks: webhooks})
+	}
+}
+
+func (h *HttpServer) CreateWebhook() http.HandlerFunc {
+	type request struct {
+		URL                string   `json:"url"`
+		Secret             string   `json:"secret"`
+		RolePatterns       []string `json:"role_patterns"`
+		PermissionPatterns []string `json:"permission_patterns"`
+		Kinds              []string `json:"kinds"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			badRequest(w, r, "invalid request body: %v", err)
+			return
+		}
+
+		id, err := h.app.Commands.CreateWebhook.Handle(r.Context(), command.CreateWebhook{
+			URL:                req.URL,
+			Secret:             req.Secret,
+			RolePatterns:       req.RolePatterns,
+			PermissionPatterns: req.PermissionPatterns,
+			Kinds:              req.Kinds,
+		})
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		webhook, err := h.app.Queries.WebhookByID.Handle(r.Context(), query.WebhookByID{ID: id})
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(webhook)
+	}
+}
+
+func (h *HttpServer) Webhook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := webhookID(w, r)
+		if !ok {
+			return
+		}
+
+		webhook, err := h.app.Queries.WebhookByID.Handle(r.Context(), query.WebhookByID{ID: id})
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(webhook)
+	}
+}
+
+func (h *HttpServer) DeleteWebhook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := webhookID(w, r)
+		if !ok {
+			return
+		}
+
+		if err := h.app.Commands.DeleteWebhook.Handle(r.Context(), command.DeleteWebhook{ID: id}); err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (h *HttpServer) WebhookDeliveries() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := webhookID(w, r)
+		if !ok {
+			return
+		}
+
+		params := r.URL.Query()
+		cmd := query.ListWebhookDeliveries{Webhook: id, PageToken: params.Get("page_token")}
+
+		if cmd.PageSize, ok = pageSize(w, r); !ok {
+			return
+		}
+
+		page, err := h.app.Queries.ListWebhookDeliveries.Handle(r.Context(), cmd)
+		if err != nil {
+			respondWithError(w, r, err)
+			return
+		}
+
+		json.NewEncoder(w).Encode(page)
+	}
+}
+
+// webhookID returns the id of the webhook in the path, responding
+// with an error and returning false if it is invalid.
+func webhookID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	v := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(v)
+	if err != nil {
+		badRequest(w, r, "invalid webhook id %q", v)
+		return 0, false
+	}
+
+	return id, true
+}
@@ -7,8 +7,47 @@ import (
 )
 
 func NewHandlerForMux(server *HttpServer, r chi.Router) http.Handler {
+	r.NotFound(notFound)
 	r.Get("/role/named", server.RoleByName())
 	r.Get("/role/permissions", server.RolesWithPermissions())
+	r.Get("/roles/least-privilege", server.LeastPrivilegeRoles())
+	r.Get("/roles/combination", server.MinimalRoleCombination())
+	r.Get("/roles/diff", server.RoleDiff())
+	r.Get("/roles/*", server.RoleResource())
+
+	return r
+}
+
+// NewV2HandlerForMux registers the path based api which identifies
+// resources by their names and accepts parameters as query parameters.
+func NewV2HandlerForMux(server *HttpServer, r chi.Router) http.Handler {
+	r.NotFound(notFound)
+	r.Get("/roles", server.Roles())
+	r.Get("/roles/least-privilege", server.RankedRoles())
+	r.Get("/roles/combination", server.MinimalRoleCombination())
+	r.Get("/roles/diff", server.RoleDiff())
+	r.Get("/roles/*", server.RoleResource())
+	r.Get("/permissions", server.Permissions())
+	r.Get("/permissions/{name}", server.Permission())
+	r.Get("/services", server.Services())
+	r.Get("/services/{service}/resources", server.ResourceTypes())
+	r.Get("/services/{service}/resources/{resource}", server.ResourceType())
+	r.Get("/changes", server.Changes())
+	r.Get("/events", server.Events())
+	r.Get("/webhooks", server.Webhooks())
+	r.Post("/webhooks", server.CreateWebhook())
+	r.Get("/webhooks/{id}", server.Webhook())
+	r.Delete("/webhooks/{id}", server.DeleteWebhook())
+	r.Get("/webhooks/{id}/deliveries", server.WebhookDeliveries())
+
+	return r
+}
+
+// NewEventsHandlerForMux registers the stream of the changes of the roles
+// at the root of r, so that it can be mounted outside the versioned apis.
+func NewEventsHandlerForMux(server *HttpServer, r chi.Router) http.Handler {
+	r.NotFound(notFound)
+	r.Get("/", server.Events())
 
 	return r
 }
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+
+	admin "cloud.google.com/go/iam/admin/apiv1"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/oklog/run"
+
+	"github.com/rosstimothy/iam/adapters"
+	"github.com/rosstimothy/iam/app"
+	"github.com/rosstimothy/iam/app/command"
+	"github.com/rosstimothy/iam/app/query"
+	"github.com/rosstimothy/iam/ports"
+)
+
+func serve(args []string) {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	organization := flags.String("organization", "", "id of an organization whose custom roles are synced")
+	projects := flags.String("projects", "", "comma separated ids of projects whose custom roles are synced")
+	importFiles := flags.String("import", "", "comma separated gcloud role exports to sync instead of querying GCP")
+	db := addDatabaseFlags(flags)
+	flags.Parse(args)
+
+	parents := customParents(*organization, *projects)
+
+	client, err := openClient(db)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer client.Close()
+
+	existing, err := client.Role.Query().Count(context.Background())
+	if err != nil {
+		fmt.Printf("failed counting existing roles: %v\n", err)
+		return
+	}
+	fmt.Printf("found %d existing roles\n", existing)
+
+	var source command.RoleSource
+	var fileSource *adapters.FileRoleSource
+	if paths := splitList(*importFiles); len(paths) > 0 {
+		fileSource = adapters.NewFileRoleSource(paths...)
+		source = fileSource
+	} else {
+		iamClient, err := admin.NewIamClient(context.Background())
+		if err != nil {
+			fmt.Printf("failed creating iam client: %v\n", err)
+			return
+		}
+		defer iamClient.Close()
+
+		source = adapters.NewGcpRoleSource(iamClient)
+	}
+
+	sender := adapters.NewHttpWebhookSender(&http.Client{Timeout: 10 * time.Second})
+
+	roleByName := query.NewRoleByNameHandler(client)
+	application := &app.Application{
+		Commands: app.Commands{
+			UpdateRoles:     command.NewUpdateRolesHandler(client, source),
+			CreateWebhook:   command.NewCreateWebhookHandler(client),
+			DeleteWebhook:   command.NewDeleteWebhookHandler(client),
+			DeliverWebhooks: command.NewDeliverWebhooksHandler(client, sender),
+		},
+		Queries: app.Queries{
+			RolesWithPermissions:   query.NewRolesWithPermissionsHandler(client),
+			MinimalRoleCombination: query.NewMinimalRoleCombinationHandler(client),
+			RoleByName:             roleByName,
+			LeastPrivilegeRoles:    query.NewLeastPrivilegeRolesHandler(client),
+			RoleDiff:               query.NewRoleDiffHandler(roleByName),
+			RelatedRoles:           query.NewRelatedRolesHandler(client),
+			RoleHistory:            query.NewRoleHistoryHandler(client),
+			PermissionByName:       query.NewPermissionByNameHandler(client),
+			ListRoles:              query.NewListRolesHandler(client),
+			ListPermissions:        query.NewListPermissionsHandler(client),
+			ListServices:           query.NewListServicesHandler(client),
+			ListResourceTypes:      query.NewListResourceTypesHandler(client),
+			ResourceTypeByName:     query.NewResourceTypeByNameHandler(client),
+			ListChanges:            query.NewListChangesHandler(client),
+			LastChangeID:           query.NewLastChangeIDHandler(client),
+			ListWebhooks:           query.NewListWebhooksHandler(client),
+			WebhookByID:            query.NewWebhookByIDHandler(client),
+			ListWebhookDeliveries:  query.NewListWebhookDeliveriesHandler(client),
+		},
+	}
+
+	broker := ports.NewChangeBroker()
+	httpServer := ports.NewHttpServer(application, broker)
+
+	rootRouter := chi.NewRouter()
+	rootRouter.Mount("/v1", ports.NewHandlerForMux(httpServer, newAPIRouter()))
+	rootRouter.Mount("/v2", ports.NewV2HandlerForMux(httpServer, newAPIRouter()))
+	rootRouter.Mount("/events", ports.NewEventsHandlerForMux(httpServer, newAPIRouter()))
+
+	srv := &http.Server{
+		Addr:    ":8080",
+		Handler: rootRouter,
+	}
+
+	// deliver triggers the delivery of webhooks after each update
+	deliver := make(chan struct{}, 1)
+
+	var g run.Group
+	{
+		g.Add(func() error {
+			fmt.Printf("Server Started on %s\n", ":8080")
+
+			return srv.ListenAndServe()
+		}, func(err error) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := srv.Shutdown(ctx); err != nil {
+				fmt.Printf("Failed to shutdown server: %v\n", err)
+			}
+		})
+	}
+	{
+		g.Add(func() error {
+			update := func() error {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+				defer cancel()
+
+				cmd := command.UpdateRoles{Parents: parents}
+				if fileSource != nil {
+					fileParents, err := fileSource.Parents(ctx)
+					if err != nil {
+						return err
+					}
+					cmd.Parents = mergeParents(parents, fileParents)
+				}
+
+				return application.Commands.UpdateRoles.Handle(ctx, cmd)
+			}
+
+			// Once the db contains roles a failed update leaves the
+			// existing roles in place to be served until the next update.
+			synced := existing > 0
+			for {
+				if err := update(); err != nil {
+					if !synced {
+						return err
+					}
+					fmt.Printf("failed to update roles, serving existing roles: %v\n", err)
+				} else {
+					synced = true
+					broker.Publish()
+
+					// deliver the changes of the update right away
+					select {
+					case deliver <- struct{}{}:
+					default:
+					}
+				}
+				time.Sleep(time.Minute * 5)
+			}
+
+		}, func(err error) {
+			fmt.Printf("failed to update roles: %v\n", err)
+		})
+	}
+
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			// retries of failed deliveries are due at the latest after a minute
+			ticker := time.NewTicker(30 * time.Second)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-deliver:
+				case <-ticker.C:
+				}
+
+				if err := application.Commands.DeliverWebhooks.Handle(ctx, command.DeliverWebhooks{}); err != nil {
+					fmt.Printf("failed to deliver webhooks: %v\n", err)
+				}
+			}
+		}, func(err error) {
+			cancel()
+		})
+	}
+
+	if err := g.Run(); err != nil {
+		fmt.Printf("The run group was terminated: %v\n", err)
+	}
+}
+
+func newAPIRouter() chi.Router {
+	apiRouter := chi.NewRouter()
+
+	apiRouter.Use(
+		middleware.SetHeader("X-Content-Type-Options", "nosniff"),
+		middleware.SetHeader("X-Frame-Options", "deny"),
+		middleware.SetHeader("Content-Type", "application/json; charset=utf-8"),
+		middleware.RequestID,
+		middleware.RealIP,
+		middleware.Recoverer,
+		middleware.NoCache,
+	)
+
+	return apiRouter
+}